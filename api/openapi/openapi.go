@@ -0,0 +1,8 @@
+// Package openapi holds the OpenAPI document generated from the proto
+// definitions, see make generate.
+package openapi
+
+import _ "embed"
+
+//go:embed customer.swagger.json
+var CustomerSpec []byte
@@ -2,17 +2,30 @@ package di
 
 import (
 	"DobrikaDev/customer-service/internal/delivery"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"DobrikaDev/customer-service/internal/service/abuse"
 	"DobrikaDev/customer-service/internal/service/customer"
+	"DobrikaDev/customer-service/internal/service/health"
+	"DobrikaDev/customer-service/internal/service/pagination"
+	"DobrikaDev/customer-service/internal/service/ratelimit"
+	"DobrikaDev/customer-service/internal/service/reputation"
+	"DobrikaDev/customer-service/internal/service/screening"
+	"DobrikaDev/customer-service/internal/service/taskverifier"
 	"DobrikaDev/customer-service/internal/storage/sql"
 	"DobrikaDev/customer-service/internal/storage/sqlxtrm"
+	"DobrikaDev/customer-service/migrations"
 	"DobrikaDev/customer-service/utils/config"
 	"context"
+	"errors"
+	"fmt"
 	"net"
 	"net/http"
+	"sync"
 
 	"github.com/jmoiron/sqlx"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -21,6 +34,12 @@ type Container struct {
 	cfg                *config.Config
 	logger             *zap.Logger
 	customerService    *customer.CustomerService
+	reputationScorer   *reputation.Scorer
+	pageTokenSigner    *pagination.Signer
+	contentFilter      screening.ContentFilter
+	taskVerifier       taskverifier.TaskVerifier
+	abuseDetector      *abuse.Detector
+	rateLimiter        ratelimit.Limiter
 	httpClient         *http.Client
 	server             *delivery.Server
 	transactionFactory *sqlxtrm.SqlxTransactionFactory
@@ -29,6 +48,14 @@ type Container struct {
 	storage            *sql.SqlStorage
 	netListener        *net.Listener
 	grpcServer         *grpc.Server
+	gatewayServer      *http.Server
+	probeServer        *http.Server
+	healthChecker      *health.Checker
+
+	closers     []closer
+	workers     sync.WaitGroup
+	workersCtx  context.Context
+	stopWorkers context.CancelFunc
 }
 
 func NewContainer(ctx context.Context, cfg *config.Config, logger *zap.Logger) *Container {
@@ -37,7 +64,102 @@ func NewContainer(ctx context.Context, cfg *config.Config, logger *zap.Logger) *
 
 func (c *Container) GetCustomerService() *customer.CustomerService {
 	return get(&c.customerService, func() *customer.CustomerService {
-		return customer.NewCustomerService(c.GetStorage(), c.cfg, c.logger)
+		return customer.NewCustomerService(c.GetStorage(), c.GetReputationScorer(), c.GetPageTokenSigner(), c.GetContentFilter(), c.GetTaskVerifier(), c.GetAbuseDetector(), c.GetRateLimiter(), c.cfg, c.logger)
+	})
+}
+
+func (c *Container) GetReputationScorer() *reputation.Scorer {
+	return get(&c.reputationScorer, func() *reputation.Scorer {
+		return reputation.NewScorer(c.cfg.Reputation)
+	})
+}
+
+func (c *Container) GetPageTokenSigner() *pagination.Signer {
+	return get(&c.pageTokenSigner, func() *pagination.Signer {
+		signer, err := pagination.NewSigner(c.cfg.Pagination.TokenSecret)
+		if err != nil {
+			panic(err)
+		}
+
+		return signer
+	})
+}
+
+func (c *Container) GetContentFilter() screening.ContentFilter {
+	return get(&c.contentFilter, func() screening.ContentFilter {
+		engine, err := screening.NewRuleEngine(c.cfg.Screening)
+		if err != nil {
+			panic(err)
+		}
+
+		return engine
+	})
+}
+
+func (c *Container) GetTaskVerifier() taskverifier.TaskVerifier {
+	return get(&c.taskVerifier, func() taskverifier.TaskVerifier {
+		if c.cfg.Task.AllowAll {
+			return taskverifier.NewAllowAllVerifier()
+		}
+		verifier, err := taskverifier.NewGRPCVerifier(c.cfg.Task.Address, c.cfg.Task.Timeout)
+		if err != nil {
+			panic(err)
+		}
+		c.onClose("task verifier", func(context.Context) error {
+			return verifier.Close()
+		})
+		if c.cfg.Task.CacheTTL <= 0 {
+			return verifier
+		}
+
+		return taskverifier.NewCachedVerifier(verifier, c.cfg.Task.CacheTTL)
+	})
+}
+
+func (c *Container) GetAbuseDetector() *abuse.Detector {
+	return get(&c.abuseDetector, func() *abuse.Detector {
+		return abuse.NewDetector(c.cfg.Abuse)
+	})
+}
+
+func (c *Container) GetRateLimiter() ratelimit.Limiter {
+	return get(&c.rateLimiter, func() ratelimit.Limiter {
+		rl := c.cfg.RateLimit
+		idle := max(rl.Caller.Period, rl.Feedback.Period, rl.Customer.Period)
+		switch rl.Backend {
+		case "", "memory":
+			return ratelimit.NewMemoryLimiter(idle)
+		case "postgres":
+			return ratelimit.NewPostgresLimiter(c.GetStorage(), idle, c.logger)
+		default:
+			panic(fmt.Errorf("unknown rate limit backend %q", rl.Backend))
+		}
+	})
+}
+
+func (c *Container) GetHealthChecker() *health.Checker {
+	return get(&c.healthChecker, func() *health.Checker {
+		provider, err := migrations.NewProvider(c.GetTransactionFactory().GetDB())
+		if err != nil {
+			panic(err)
+		}
+
+		checker := health.NewChecker(
+			[]string{customerpb.CustomerService_ServiceDesc.ServiceName},
+			[]health.Check{
+				health.PingCheck(c.GetTransactionFactory().GetDB()),
+				health.MigrationCheck(provider),
+			},
+			c.cfg.Health.Interval,
+			c.cfg.Health.Timeout,
+			c.logger,
+		)
+		c.onClose("health checker", func(context.Context) error {
+			checker.Shutdown()
+			return nil
+		})
+
+		return checker
 	})
 }
 
@@ -60,7 +182,12 @@ func (c *Container) GetTransactionManager() *sqlxtrm.SqlxTransactionManager {
 
 func (c *Container) GetDB() *sqlx.DB {
 	return get(&c.db, func() *sqlx.DB {
-		return sql.MustCreateDB(c.cfg)
+		db := sql.MustCreateDB(c.cfg)
+		c.onClose("db", func(context.Context) error {
+			return db.Close()
+		})
+
+		return db
 	})
 }
 
@@ -82,18 +209,94 @@ func (c *Container) GetNetListener() *net.Listener {
 		if err != nil {
 			panic(err)
 		}
+		c.onClose("listener", func(context.Context) error {
+			// Stopping the gRPC server closes the listener already.
+			if err := listener.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+				return err
+			}
+			return nil
+		})
 		return &listener
 	})
 }
 
 func (c *Container) GetGRPCServer() *grpc.Server {
 	return get(&c.grpcServer, func() *grpc.Server {
-		grpcServer := grpc.NewServer()
+		errorStatus, err := delivery.ErrorStatusInterceptor(c.cfg.ErrorMode)
+		if err != nil {
+			panic(err)
+		}
+		rateLimit, err := delivery.RateLimitInterceptor(c.GetRateLimiter(), c.cfg.RateLimit, c.logger)
+		if err != nil {
+			panic(err)
+		}
+		grpcServer := grpc.NewServer(
+			grpc.ChainUnaryInterceptor(
+				delivery.RequestIDInterceptor(c.logger),
+				delivery.AccessLogInterceptor(c.logger),
+				errorStatus,
+				delivery.RecoveryInterceptor(c.logger),
+				rateLimit,
+			),
+		)
 
+		// The services are built, and register their closers, before the
+		// server, so that Close drains the server while they are still open.
+		customerpb.RegisterCustomerServiceServer(grpcServer, c.GetRpcServer())
+		healthpb.RegisterHealthServer(grpcServer, c.GetHealthChecker().Server())
 		reflection.Register(grpcServer)
+		c.onClose("grpc server", func(ctx context.Context) error {
+			stopped := make(chan struct{})
+			go func() {
+				grpcServer.GracefulStop()
+				close(stopped)
+			}()
+			select {
+			case <-stopped:
+				return nil
+			case <-ctx.Done():
+				grpcServer.Stop()
+				return ctx.Err()
+			}
+		})
+
 		return grpcServer
 	})
 }
+func (c *Container) GetGatewayServer() *http.Server {
+	return get(&c.gatewayServer, func() *http.Server {
+		// The connection to the gRPC server outlives the HTTP server, so that
+		// calls in flight can finish while it drains.
+		ctx, cancel := context.WithCancel(c.ctx)
+		handler, err := delivery.NewGateway(ctx, "localhost:"+c.cfg.Port, c.cfg.RateLimit)
+		if err != nil {
+			cancel()
+			panic(err)
+		}
+		server := &http.Server{Addr: ":" + c.cfg.Gateway.Port, Handler: handler}
+		c.onClose("gateway server", func(ctx context.Context) error {
+			defer cancel()
+			return server.Shutdown(ctx)
+		})
+
+		return server
+	})
+}
+
+func (c *Container) GetProbeServer() *http.Server {
+	return get(&c.probeServer, func() *http.Server {
+		server := &http.Server{
+			Addr:    ":" + c.cfg.Health.Port,
+			Handler: delivery.NewProbeHandler(c.GetHealthChecker()),
+		}
+		c.onClose("probe server", func(ctx context.Context) error {
+			return server.Shutdown(ctx)
+		})
+
+		return server
+	})
+}
+
 func (c *Container) GetRpcServer() *delivery.Server {
 	return get(&c.server, func() *delivery.Server {
 		return delivery.NewServer(c.ctx, c.GetCustomerService(), c.cfg, c.logger)
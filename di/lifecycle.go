@@ -0,0 +1,66 @@
+package di
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+type closer struct {
+	name  string
+	close func(ctx context.Context) error
+}
+
+// onClose registers the teardown of a dependency. Getters call it once their
+// own dependencies are built, and servers once everything they serve is
+// built, so closing in reverse order never pulls a dependency from under
+// something still using it.
+func (c *Container) onClose(name string, fn func(ctx context.Context) error) {
+	c.closers = append(c.closers, closer{name: name, close: fn})
+}
+
+// Go runs a background worker until Close. The worker must return once its
+// context is cancelled.
+func (c *Container) Go(worker func(ctx context.Context)) {
+	if c.stopWorkers == nil {
+		var ctx context.Context
+		ctx, c.stopWorkers = context.WithCancel(c.ctx)
+		c.workersCtx = ctx
+		c.onClose("workers", func(ctx context.Context) error {
+			c.stopWorkers()
+			done := make(chan struct{})
+			go func() {
+				c.workers.Wait()
+				close(done)
+			}()
+			select {
+			case <-done:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}
+
+	c.workers.Add(1)
+	go func() {
+		defer c.workers.Done()
+		worker(c.workersCtx)
+	}()
+}
+
+// Close tears down everything the container built, in reverse order. Servers
+// drain in-flight calls until ctx is done and are stopped hard after that.
+func (c *Container) Close(ctx context.Context) error {
+	var errs []error
+	for i := len(c.closers) - 1; i >= 0; i-- {
+		cl := c.closers[i]
+		if err := cl.close(ctx); err != nil {
+			c.logger.Error("failed to close", zap.String("name", cl.name), zap.Error(err))
+			errs = append(errs, err)
+		}
+	}
+	c.closers = nil
+	return errors.Join(errs...)
+}
@@ -5,6 +5,8 @@ import (
 	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
 	"DobrikaDev/customer-service/internal/service/customer"
 	"context"
+	"errors"
+	"slices"
 
 	"github.com/dr3dnought/gospadi"
 	"go.uber.org/zap"
@@ -13,26 +15,17 @@ import (
 func (s *Server) CreateCustomer(ctx context.Context, req *customerpb.CreateCustomerRequest) (*customerpb.CreateCustomerResponse, error) {
 	if req.Customer == nil {
 		return &customerpb.CreateCustomerResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "customer is required",
-			},
+			Error: newValidationError("customer is required", "Customer"),
 		}, nil
 	}
 	if req.Customer.MaxId == "" {
 		return &customerpb.CreateCustomerResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "max id is required",
-			},
+			Error: newValidationError("max id is required", "Customer.max_id"),
 		}, nil
 	}
 	if req.Customer.Name == "" {
 		return &customerpb.CreateCustomerResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "name is required",
-			},
+			Error: newValidationError("name is required", "Customer.name"),
 		}, nil
 	}
 
@@ -49,7 +42,7 @@ func (s *Server) CreateCustomer(ctx context.Context, req *customerpb.CreateCusto
 		}, nil
 	}
 
-	s.logger.Info("customer created", zap.Any("customer", customer))
+	s.log(ctx).Info("customer created", zap.Any("customer", customer))
 
 	return &customerpb.CreateCustomerResponse{
 		Customer: convertCustomerToProto(customer),
@@ -57,34 +50,67 @@ func (s *Server) CreateCustomer(ctx context.Context, req *customerpb.CreateCusto
 }
 
 func (s *Server) GetCustomers(ctx context.Context, req *customerpb.GetCustomersRequest) (*customerpb.GetCustomersResponse, error) {
-	if req.MaxId == "" {
+	if req.PageToken != "" && !isDefaultCustomerOrder(req.SortBy, req.SortDirection) {
 		return &customerpb.GetCustomersResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "max id is required",
-			},
+			Error: newValidationError("page token only works with the default created_at descending order", "page_token", "sort_by"),
 		}, nil
 	}
-	customers, count, err := s.customerService.GetCustomers(ctx, req.MaxId, int(req.Limit), int(req.Offset))
+	filter := &domain.CustomerFilter{
+		MaxID:         req.MaxId,
+		NameQuery:     req.NameQuery,
+		CreatedFrom:   convertUnixToTime(req.CreatedFrom),
+		CreatedTo:     convertUnixToTime(req.CreatedTo),
+		UpdatedFrom:   convertUnixToTime(req.UpdatedFrom),
+		UpdatedTo:     convertUnixToTime(req.UpdatedTo),
+		SortBy:        convertCustomerSortFieldToDomain(req.SortBy),
+		SortDirection: convertSortDirectionToDomain(req.SortDirection),
+	}
+	if req.Type != customerpb.CustomerType_CUSTOMER_TYPE_UNSPECIFIED {
+		filter.Type = converCustomerTypeToDomain(req.Type)
+	}
+	page := domain.Page{Limit: int(req.Limit), Offset: int(req.Offset), Token: req.PageToken}
+	customers, count, nextPageToken, err := s.customerService.GetCustomers(ctx, filter, page)
 	if err != nil {
 		return &customerpb.GetCustomersResponse{
 			Error: convertErrorToProto(err),
 		}, nil
 	}
-	s.logger.Info("customers fetched", zap.Any("customers", customers), zap.Int("count", count))
+	s.log(ctx).Info("customers fetched", zap.Any("customers", customers), zap.Int("count", count))
 	return &customerpb.GetCustomersResponse{
-		Customers: gospadi.Map(customers, convertCustomerToProto),
-		Total:     int32(count),
+		Customers:     gospadi.Map(customers, convertCustomerToProto),
+		Total:         int32(count),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) SearchCustomers(ctx context.Context, req *customerpb.SearchCustomersRequest) (*customerpb.SearchCustomersResponse, error) {
+	if req.Query == "" {
+		return &customerpb.SearchCustomersResponse{
+			Error: newValidationError("query is required", "query"),
+		}, nil
+	}
+	var customerType domain.CustomerType
+	if req.Type != customerpb.CustomerType_CUSTOMER_TYPE_UNSPECIFIED {
+		customerType = converCustomerTypeToDomain(req.Type)
+	}
+	page := domain.Page{Limit: int(req.Limit), Offset: int(req.Offset)}
+	hits, count, err := s.customerService.SearchCustomers(ctx, req.Query, customerType, page)
+	if err != nil {
+		return &customerpb.SearchCustomersResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("customers searched", zap.String("query", req.Query), zap.Int("count", count))
+	return &customerpb.SearchCustomersResponse{
+		Results: gospadi.Map(hits, convertCustomerSearchHitToProto),
+		Total:   int32(count),
 	}, nil
 }
 
 func (s *Server) GetCustomerByMaxID(ctx context.Context, req *customerpb.GetCustomerByMaxIDRequest) (*customerpb.GetCustomerByMaxIDResponse, error) {
 	if req.MaxId == "" {
 		return &customerpb.GetCustomerByMaxIDResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "max id is required",
-			},
+			Error: newValidationError("max id is required", "max_id"),
 		}, nil
 	}
 	customer, err := s.customerService.GetCustomerByMaxID(ctx, req.MaxId)
@@ -93,7 +119,7 @@ func (s *Server) GetCustomerByMaxID(ctx context.Context, req *customerpb.GetCust
 			Error: convertErrorToProto(err),
 		}, nil
 	}
-	s.logger.Info("customer fetched", zap.Any("customer", customer))
+	s.log(ctx).Info("customer fetched", zap.Any("customer", customer))
 	return &customerpb.GetCustomerByMaxIDResponse{
 		Customer: convertCustomerToProto(customer),
 	}, nil
@@ -102,71 +128,138 @@ func (s *Server) GetCustomerByMaxID(ctx context.Context, req *customerpb.GetCust
 func (s *Server) UpdateCustomer(ctx context.Context, req *customerpb.UpdateCustomerRequest) (*customerpb.UpdateCustomerResponse, error) {
 	if req.Customer == nil {
 		return &customerpb.UpdateCustomerResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "customer is required",
-			},
+			Error: newValidationError("customer is required", "Customer"),
 		}, nil
 	}
 	if req.Customer.MaxId == "" {
 		return &customerpb.UpdateCustomerResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "max id is required",
-			},
+			Error: newValidationError("max id is required", "Customer.max_id"),
 		}, nil
 	}
 
+	var fields []string
+	if len(req.UpdateMask.GetPaths()) > 0 {
+		if !req.UpdateMask.IsValid(req.Customer) {
+			return &customerpb.UpdateCustomerResponse{
+				Error: newValidationError("update mask is invalid", "update_mask"),
+			}, nil
+		}
+		req.UpdateMask.Normalize()
+		fields = req.UpdateMask.GetPaths()
+		if slices.Contains(fields, domain.CustomerFieldType) && req.Customer.Type == customerpb.CustomerType_CUSTOMER_TYPE_UNSPECIFIED {
+			return &customerpb.UpdateCustomerResponse{
+				Error: newValidationError("type is required", "Customer.type"),
+			}, nil
+		}
+	}
+
 	customer := &domain.Customer{
-		MaxID: req.Customer.MaxId,
-		Name:  req.Customer.Name,
-		About: req.Customer.About,
-		Type:  converCustomerTypeToDomain(req.Customer.Type),
+		MaxID:   req.Customer.MaxId,
+		Name:    req.Customer.Name,
+		About:   req.Customer.About,
+		Type:    converCustomerTypeToDomain(req.Customer.Type),
+		Version: req.ExpectedVersion,
 	}
-	customer, err := s.customerService.UpdateCustomer(ctx, customer)
+	customer, err := s.customerService.UpdateCustomer(ctx, customer, fields)
 	if err != nil {
 		return &customerpb.UpdateCustomerResponse{
 			Error: convertErrorToProto(err),
 		}, nil
 	}
-	s.logger.Info("customer updated", zap.Any("customer", customer))
+	s.log(ctx).Info("customer updated", zap.Any("customer", customer))
 
 	return &customerpb.UpdateCustomerResponse{
 		Customer: convertCustomerToProto(customer),
 	}, nil
 }
 
+// isDefaultCustomerOrder tells whether customers are listed newest first, the
+// only order page tokens can continue.
+func isDefaultCustomerOrder(sortBy customerpb.CustomerSortField, direction customerpb.SortDirection) bool {
+	switch sortBy {
+	case customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_UNSPECIFIED, customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_CREATED_AT:
+		return direction != customerpb.SortDirection_SORT_DIRECTION_ASC
+	}
+	return false
+}
+
 func (s *Server) DeleteCustomer(ctx context.Context, req *customerpb.DeleteCustomerRequest) (*customerpb.DeleteCustomerResponse, error) {
 	if req.MaxId == "" {
 		return &customerpb.DeleteCustomerResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "max id is required",
-			},
+			Error: newValidationError("max id is required", "max_id"),
 		}, nil
 	}
-	err := s.customerService.DeleteCustomer(ctx, req.MaxId)
+	err := s.customerService.DeleteCustomer(ctx, req.MaxId, req.ExpectedVersion)
 	if err != nil {
 		return &customerpb.DeleteCustomerResponse{
 			Error: convertErrorToProto(err),
 		}, nil
 	}
-	s.logger.Info("customer deleted", zap.String("max_id", req.MaxId))
+	s.log(ctx).Info("customer deleted", zap.String("max_id", req.MaxId))
 	return &customerpb.DeleteCustomerResponse{
 		MaxId: req.MaxId,
 	}, nil
 }
 
+func (s *Server) RestoreCustomer(ctx context.Context, req *customerpb.RestoreCustomerRequest) (*customerpb.RestoreCustomerResponse, error) {
+	if req.MaxId == "" {
+		return &customerpb.RestoreCustomerResponse{
+			Error: newValidationError("max id is required", "max_id"),
+		}, nil
+	}
+	customer, err := s.customerService.RestoreCustomer(ctx, req.MaxId)
+	if err != nil {
+		return &customerpb.RestoreCustomerResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("customer restored", zap.String("max_id", req.MaxId))
+	return &customerpb.RestoreCustomerResponse{
+		Customer: convertCustomerToProto(customer),
+	}, nil
+}
+
+func (s *Server) PurgeCustomer(ctx context.Context, req *customerpb.PurgeCustomerRequest) (*customerpb.PurgeCustomerResponse, error) {
+	if req.MaxId == "" {
+		return &customerpb.PurgeCustomerResponse{
+			Error: newValidationError("max id is required", "max_id"),
+		}, nil
+	}
+	err := s.customerService.PurgeCustomer(ctx, req.MaxId)
+	if err != nil {
+		return &customerpb.PurgeCustomerResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("customer purged", zap.String("max_id", req.MaxId))
+	return &customerpb.PurgeCustomerResponse{
+		MaxId: req.MaxId,
+	}, nil
+}
+
 func convertCustomerToProto(customer *domain.Customer) *customerpb.Customer {
 	return &customerpb.Customer{
-		MaxId:     customer.MaxID,
-		Name:      customer.Name,
-		About:     customer.About,
-		Type:      convertCustomerTypeToProto(customer.Type),
-		CreatedAt: int32(customer.CreatedAt.Unix()),
-		UpdatedAt: int32(customer.UpdatedAt.Unix()),
+		MaxId:      customer.MaxID,
+		Name:       customer.Name,
+		About:      customer.About,
+		Type:       convertCustomerTypeToProto(customer.Type),
+		CreatedAt:  int32(customer.CreatedAt.Unix()),
+		UpdatedAt:  int32(customer.UpdatedAt.Unix()),
+		Reputation: customer.Reputation,
+		Version:    customer.Version,
+		Screening:  convertScreeningVerdictToProto(customer.Screening),
+		Hidden:     customer.HiddenAt != nil,
+	}
+}
+func convertCustomerSearchHitToProto(hit *domain.CustomerSearchHit) *customerpb.CustomerSearchResult {
+	return &customerpb.CustomerSearchResult{
+		Customer:      convertCustomerToProto(&hit.Customer),
+		Rank:          hit.Rank,
+		NameHighlight: hit.NameHighlight,
+		AboutSnippet:  hit.AboutSnippet,
 	}
 }
+
 func converCustomerTypeToDomain(customerType customerpb.CustomerType) domain.CustomerType {
 	switch customerType {
 	case customerpb.CustomerType_CUSTOMER_TYPE_INDIVIDUAL:
@@ -186,7 +279,34 @@ func convertCustomerTypeToProto(customerType domain.CustomerType) customerpb.Cus
 	return customerpb.CustomerType_CUSTOMER_TYPE_UNSPECIFIED
 }
 
+func convertCustomerSortFieldToDomain(sortField customerpb.CustomerSortField) domain.CustomerSortField {
+	switch sortField {
+	case customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_REPUTATION:
+		return domain.CustomerSortByReputation
+	case customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_UPDATED_AT:
+		return domain.CustomerSortByUpdatedAt
+	case customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_NAME:
+		return domain.CustomerSortByName
+	}
+	return domain.CustomerSortByCreatedAt
+}
+
+func convertSortDirectionToDomain(direction customerpb.SortDirection) domain.SortDirection {
+	switch direction {
+	case customerpb.SortDirection_SORT_DIRECTION_ASC:
+		return domain.SortAsc
+	case customerpb.SortDirection_SORT_DIRECTION_DESC:
+		return domain.SortDesc
+	}
+	return ""
+}
+
 func convertErrorToProto(err error) *customerpb.Error {
+	var rateLimited *customer.RateLimitError
+	if errors.As(err, &rateLimited) {
+		return convertRateLimitToProto(rateLimited.RetryAfter)
+	}
+
 	switch err {
 	case customer.ErrCustomerNotFound:
 		return &customerpb.Error{
@@ -208,6 +328,16 @@ func convertErrorToProto(err error) *customerpb.Error {
 			Code:    customerpb.ErrorCode_ERROR_CODE_INTERNAL,
 			Message: err.Error(),
 		}
+	case customer.ErrCustomerRejected:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
+			Message: err.Error(),
+		}
+	case customer.ErrCustomerConflict:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_CONFLICT,
+			Message: err.Error(),
+		}
 	case customer.ErrFeedbackNotFound:
 		return &customerpb.Error{
 			Code:    customerpb.ErrorCode_ERROR_CODE_NOT_FOUND,
@@ -218,12 +348,52 @@ func convertErrorToProto(err error) *customerpb.Error {
 			Code:    customerpb.ErrorCode_ERROR_CODE_ALREADY_EXISTS,
 			Message: err.Error(),
 		}
-	case customer.ErrFeedbackInvalid:
+	case customer.ErrFeedbackReplyNotFound:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_NOT_FOUND,
+			Message: err.Error(),
+		}
+	case customer.ErrFeedbackReplyAlreadyExists:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_ALREADY_EXISTS,
+			Message: err.Error(),
+		}
+	case customer.ErrFeedbackReplyInvalid, customer.ErrFeedbackReplyRejected:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
+			Message: err.Error(),
+		}
+	case customer.ErrFeedbackInvalid, customer.ErrFeedbackRejected:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
+			Message: err.Error(),
+		}
+	case customer.ErrFeedbackForbidden, customer.ErrFeedbackEditWindowExpired, customer.ErrFeedbackReplyForbidden, customer.ErrFeedbackNotEligible:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_FORBIDDEN,
+			Message: err.Error(),
+		}
+	case customer.ErrTaskServiceUnavailable:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_UNAVAILABLE,
+			Message: err.Error(),
+		}
+	case customer.ErrReportNotFound:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_NOT_FOUND,
+			Message: err.Error(),
+		}
+	case customer.ErrReportAlreadyExists:
+		return &customerpb.Error{
+			Code:    customerpb.ErrorCode_ERROR_CODE_ALREADY_EXISTS,
+			Message: err.Error(),
+		}
+	case customer.ErrReportInvalid:
 		return &customerpb.Error{
 			Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
 			Message: err.Error(),
 		}
-	case customer.ErrFeedbackInternal:
+	case customer.ErrFeedbackInternal, customer.ErrReportInternal:
 		return &customerpb.Error{
 			Code:    customerpb.ErrorCode_ERROR_CODE_INTERNAL,
 			Message: err.Error(),
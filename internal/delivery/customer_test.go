@@ -0,0 +1,46 @@
+package delivery
+
+import (
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+	"testing"
+)
+
+func TestGetCustomersPageTokenOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		sortBy    customerpb.CustomerSortField
+		direction customerpb.SortDirection
+		wantValid bool
+	}{
+		{name: "default order", wantValid: true},
+		{name: "created at", sortBy: customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_CREATED_AT, wantValid: true},
+		{name: "created at descending", sortBy: customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_CREATED_AT, direction: customerpb.SortDirection_SORT_DIRECTION_DESC, wantValid: true},
+		{name: "created at ascending", sortBy: customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_CREATED_AT, direction: customerpb.SortDirection_SORT_DIRECTION_ASC},
+		{name: "reputation", sortBy: customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_REPUTATION},
+		{name: "name", sortBy: customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_NAME},
+		{name: "updated at", sortBy: customerpb.CustomerSortField_CUSTOMER_SORT_FIELD_UPDATED_AT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDefaultCustomerOrder(tt.sortBy, tt.direction); got != tt.wantValid {
+				t.Fatalf("isDefaultCustomerOrder(%v, %v) = %v, want %v", tt.sortBy, tt.direction, got, tt.wantValid)
+			}
+			if tt.wantValid {
+				return
+			}
+
+			server, _ := newTestServer(t, nil)
+			resp, err := server.GetCustomers(context.Background(), &customerpb.GetCustomersRequest{
+				PageToken: "token", SortBy: tt.sortBy, SortDirection: tt.direction,
+			})
+			if err != nil {
+				t.Fatalf("GetCustomers() error = %v", err)
+			}
+			if got := resp.GetError().GetCode(); got != customerpb.ErrorCode_ERROR_CODE_VALIDATION {
+				t.Errorf("GetCustomers() error code = %v, want %v", got, customerpb.ErrorCode_ERROR_CODE_VALIDATION)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"DobrikaDev/customer-service/internal/domain"
 	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
 	"context"
+	"time"
 
 	"github.com/dr3dnought/gospadi"
 	"go.uber.org/zap"
@@ -12,10 +13,7 @@ import (
 func (s *Server) CreateFeedback(ctx context.Context, req *customerpb.CreateFeedbackRequest) (*customerpb.CreateFeedbackResponse, error) {
 	if req.Feedback == nil {
 		return &customerpb.CreateFeedbackResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "feedback is required",
-			},
+			Error: newValidationError("feedback is required", "Feedback"),
 		}, nil
 	}
 	feedback, err := s.customerService.CreateFeedback(ctx, &domain.Feedback{
@@ -24,47 +22,125 @@ func (s *Server) CreateFeedback(ctx context.Context, req *customerpb.CreateFeedb
 		Rating:     int(req.Feedback.Rating),
 		Comment:    req.Feedback.Comment,
 		TaskID:     req.Feedback.TaskId,
-	})
+		Scores:     convertCriterionScoresToDomain(req.Feedback.Scores),
+	}, req.Upsert)
 	if err != nil {
 		return &customerpb.CreateFeedbackResponse{
 			Error: convertErrorToProto(err),
 		}, nil
 	}
-	s.logger.Info("feedback created", zap.Any("feedback", feedback))
+	s.log(ctx).Info("feedback created", zap.Any("feedback", feedback))
 	return &customerpb.CreateFeedbackResponse{
 		Feedback: convertFeedbackToProto(feedback),
 	}, nil
 }
 
+func (s *Server) UpdateFeedback(ctx context.Context, req *customerpb.UpdateFeedbackRequest) (*customerpb.UpdateFeedbackResponse, error) {
+	if req.Id == "" {
+		return &customerpb.UpdateFeedbackResponse{
+			Error: newValidationError("id is required", "id"),
+		}, nil
+	}
+	if req.UserId == "" {
+		return &customerpb.UpdateFeedbackResponse{
+			Error: newValidationError("user id is required", "user_id"),
+		}, nil
+	}
+	if len(req.Scores) > 0 && !req.ReplaceScores {
+		return &customerpb.UpdateFeedbackResponse{
+			Error: newValidationError("scores are only applied with replace_scores", "replace_scores"),
+		}, nil
+	}
+	feedback := &domain.Feedback{
+		ID:      req.Id,
+		Rating:  int(req.Rating),
+		Comment: req.Comment,
+	}
+	if req.ReplaceScores {
+		feedback.Scores = convertCriterionScoresToDomain(req.Scores)
+	}
+	feedback, err := s.customerService.UpdateFeedback(ctx, req.UserId, feedback)
+	if err != nil {
+		return &customerpb.UpdateFeedbackResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedback updated", zap.Any("feedback", feedback))
+	return &customerpb.UpdateFeedbackResponse{
+		Feedback: convertFeedbackToProto(feedback),
+	}, nil
+}
+
+func (s *Server) DeleteFeedback(ctx context.Context, req *customerpb.DeleteFeedbackRequest) (*customerpb.DeleteFeedbackResponse, error) {
+	if req.Id == "" {
+		return &customerpb.DeleteFeedbackResponse{
+			Error: newValidationError("id is required", "id"),
+		}, nil
+	}
+	if req.UserId == "" {
+		return &customerpb.DeleteFeedbackResponse{
+			Error: newValidationError("user id is required", "user_id"),
+		}, nil
+	}
+	err := s.customerService.DeleteFeedback(ctx, req.UserId, req.Id)
+	if err != nil {
+		return &customerpb.DeleteFeedbackResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedback deleted", zap.String("id", req.Id), zap.String("user_id", req.UserId))
+	return &customerpb.DeleteFeedbackResponse{
+		Id: req.Id,
+	}, nil
+}
+
 func (s *Server) GetFeedbacks(ctx context.Context, req *customerpb.GetFeedbacksRequest) (*customerpb.GetFeedbacksResponse, error) {
 	if req.TaskId == "" {
 		return &customerpb.GetFeedbacksResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "task id is required",
-			},
+			Error: newValidationError("task id is required", "task_id"),
 		}, nil
 	}
-	feedbacks, count, err := s.customerService.GetFeedbacks(ctx, req.TaskId, req.UserId, int(req.Limit), int(req.Offset))
+	page := domain.Page{Limit: int(req.Limit), Offset: int(req.Offset), Token: req.PageToken}
+	feedbacks, count, nextPageToken, err := s.customerService.GetFeedbacks(ctx, req.TaskId, req.UserId, page)
 	if err != nil {
 		return &customerpb.GetFeedbacksResponse{
 			Error: convertErrorToProto(err),
 		}, nil
 	}
-	s.logger.Info("feedbacks fetched", zap.Any("feedbacks", feedbacks), zap.Int("count", count))
+	s.log(ctx).Info("feedbacks fetched", zap.Any("feedbacks", feedbacks), zap.Int("count", count))
 	return &customerpb.GetFeedbacksResponse{
-		Feedbacks: gospadi.Map(feedbacks, convertFeedbackToProto),
-		Total:     int32(count),
+		Feedbacks:     gospadi.Map(feedbacks, convertFeedbackToProto),
+		Total:         int32(count),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) CountFeedbacks(ctx context.Context, req *customerpb.CountFeedbacksRequest) (*customerpb.CountFeedbacksResponse, error) {
+	filter := &domain.FeedbackFilter{
+		TaskID:      req.TaskId,
+		UserID:      req.UserId,
+		CustomerID:  req.CustomerId,
+		MinRating:   int(req.MinRating),
+		MaxRating:   int(req.MaxRating),
+		CreatedFrom: convertUnixToTime(req.CreatedFrom),
+		CreatedTo:   convertUnixToTime(req.CreatedTo),
+	}
+	count, err := s.customerService.CountFeedbacks(ctx, filter)
+	if err != nil {
+		return &customerpb.CountFeedbacksResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedbacks counted", zap.Any("filter", filter), zap.Int("count", count))
+	return &customerpb.CountFeedbacksResponse{
+		Total: int32(count),
 	}, nil
 }
 
 func (s *Server) GetFeedbackByID(ctx context.Context, req *customerpb.GetFeedbackByIDRequest) (*customerpb.GetFeedbackByIDResponse, error) {
 	if req.Id == "" {
 		return &customerpb.GetFeedbackByIDResponse{
-			Error: &customerpb.Error{
-				Code:    customerpb.ErrorCode_ERROR_CODE_VALIDATION,
-				Message: "id is required",
-			},
+			Error: newValidationError("id is required", "id"),
 		}, nil
 	}
 	feedback, err := s.customerService.GetFeedbackByID(ctx, req.Id)
@@ -73,7 +149,7 @@ func (s *Server) GetFeedbackByID(ctx context.Context, req *customerpb.GetFeedbac
 			Error: convertErrorToProto(err),
 		}, nil
 	}
-	s.logger.Info("feedback fetched", zap.Any("feedback", feedback))
+	s.log(ctx).Info("feedback fetched", zap.Any("feedback", feedback))
 	return &customerpb.GetFeedbackByIDResponse{
 		Feedback: convertFeedbackToProto(feedback),
 	}, nil
@@ -81,11 +157,47 @@ func (s *Server) GetFeedbackByID(ctx context.Context, req *customerpb.GetFeedbac
 
 func convertFeedbackToProto(feedback *domain.Feedback) *customerpb.Feedback {
 	return &customerpb.Feedback{
-		Id:        feedback.ID,
-		Rating:    int32(feedback.Rating),
-		Comment:   feedback.Comment,
-		TaskId:    feedback.TaskID,
-		CreatedAt: int32(feedback.CreatedAt.Unix()),
-		UpdatedAt: int32(feedback.UpdatedAt.Unix()),
+		Id:         feedback.ID,
+		Rating:     int32(feedback.Rating),
+		Comment:    feedback.Comment,
+		TaskId:     feedback.TaskID,
+		UserId:     feedback.UserID,
+		CustomerId: feedback.CustomerID,
+		Status:     convertFeedbackStatusToProto(feedback.Status),
+		CreatedAt:  int32(feedback.CreatedAt.Unix()),
+		UpdatedAt:  int32(feedback.UpdatedAt.Unix()),
+		Screening:  convertScreeningVerdictToProto(feedback.Screening),
+		Reply:      convertFeedbackReplyToProto(feedback.Reply),
+		Scores:     convertCriterionScoresToProto(feedback.Scores),
+		Abuse:      convertAbuseAssessmentToProto(feedback.Abuse),
+	}
+}
+
+func convertCriterionScoresToDomain(scores []*customerpb.CriterionScore) []domain.CriterionScore {
+	converted := make([]domain.CriterionScore, 0, len(scores))
+	for _, score := range scores {
+		converted = append(converted, domain.CriterionScore{
+			Criterion: score.Criterion,
+			Score:     int(score.Score),
+		})
+	}
+	return converted
+}
+
+func convertCriterionScoresToProto(scores []domain.CriterionScore) []*customerpb.CriterionScore {
+	converted := make([]*customerpb.CriterionScore, 0, len(scores))
+	for _, score := range scores {
+		converted = append(converted, &customerpb.CriterionScore{
+			Criterion: score.Criterion,
+			Score:     int32(score.Score),
+		})
+	}
+	return converted
+}
+
+func convertUnixToTime(ts int32) time.Time {
+	if ts <= 0 {
+		return time.Time{}
 	}
+	return time.Unix(int64(ts), 0)
 }
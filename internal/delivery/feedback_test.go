@@ -0,0 +1,111 @@
+package delivery
+
+import (
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCountFeedbacks(t *testing.T) {
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		req       *customerpb.CountFeedbacksRequest
+		wantQuery string
+		wantArgs  []driver.Value
+		wantCode  customerpb.ErrorCode
+	}{
+		{
+			name:      "published only",
+			req:       &customerpb.CountFeedbacksRequest{},
+			wantQuery: "SELECT COUNT(*) FROM feedbacks f WHERE f.status IN ($1)",
+			wantArgs:  []driver.Value{"published"},
+		},
+		{
+			name: "customer ratings in a day",
+			req: &customerpb.CountFeedbacksRequest{
+				CustomerId:  "customer-1",
+				MinRating:   4,
+				CreatedFrom: int32(from.Unix()),
+				CreatedTo:   int32(to.Unix()),
+			},
+			wantQuery: "SELECT COUNT(*) FROM feedbacks f WHERE f.customer_id = $1 AND f.rating >= $2" +
+				" AND f.created_at >= $3 AND f.created_at < $4 AND f.status IN ($5)",
+			wantArgs: []driver.Value{"customer-1", 4, from.Local(), to.Local(), "published"},
+		},
+		{
+			name:     "inverted rating range",
+			req:      &customerpb.CountFeedbacksRequest{MinRating: 5, MaxRating: 1},
+			wantCode: customerpb.ErrorCode_ERROR_CODE_VALIDATION,
+		},
+		{
+			name:     "inverted date range",
+			req:      &customerpb.CountFeedbacksRequest{CreatedFrom: int32(to.Unix()), CreatedTo: int32(from.Unix())},
+			wantCode: customerpb.ErrorCode_ERROR_CODE_VALIDATION,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, mock := newTestServer(t, nil)
+			if tt.wantQuery != "" {
+				mock.ExpectQuery(tt.wantQuery).
+					WithArgs(tt.wantArgs...).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+			}
+
+			resp, err := server.CountFeedbacks(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("CountFeedbacks() error = %v", err)
+			}
+			if got := resp.GetError().GetCode(); got != tt.wantCode {
+				t.Fatalf("CountFeedbacks() error code = %v, want %v", got, tt.wantCode)
+			}
+			if tt.wantCode == customerpb.ErrorCode_ERROR_CODE_UNSPECIFIED && resp.Total != 12 {
+				t.Errorf("CountFeedbacks() total = %d, want 12", resp.Total)
+			}
+		})
+	}
+}
+
+func TestUpdateFeedbackValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *customerpb.UpdateFeedbackRequest
+		wantField string
+	}{
+		{name: "no id", req: &customerpb.UpdateFeedbackRequest{UserId: "user-1"}, wantField: "id"},
+		{name: "no user", req: &customerpb.UpdateFeedbackRequest{Id: "feedback-1"}, wantField: "user_id"},
+		{
+			name: "scores without replace_scores",
+			req: &customerpb.UpdateFeedbackRequest{
+				Id: "feedback-1", UserId: "user-1", Rating: 5,
+				Scores: []*customerpb.CriterionScore{{Criterion: "safety", Score: 5}},
+			},
+			wantField: "replace_scores",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, _ := newTestServer(t, nil)
+
+			resp, err := server.UpdateFeedback(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("UpdateFeedback() error = %v", err)
+			}
+			if got := resp.GetError().GetCode(); got != customerpb.ErrorCode_ERROR_CODE_VALIDATION {
+				t.Fatalf("UpdateFeedback() error code = %v, want %v", got, customerpb.ErrorCode_ERROR_CODE_VALIDATION)
+			}
+			if violations := resp.GetError().GetViolations(); len(violations) != 1 || violations[0].Field != tt.wantField {
+				t.Errorf("UpdateFeedback() violations = %v, want field %q", violations, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/api/openapi"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewGateway returns an HTTP handler that translates REST/JSON calls into
+// calls of the gRPC server at grpcAddr, so they pass the same interceptors as
+// native gRPC clients. The OpenAPI document is served at /openapi.json.
+func NewGateway(ctx context.Context, grpcAddr string, cfg config.RateLimit) (http.Handler, error) {
+	proxies, err := parseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeaderMatcher),
+		runtime.WithForwardResponseOption(setErrorHTTPStatus),
+		runtime.WithMetadata(func(_ context.Context, r *http.Request) metadata.MD {
+			return proxies.callerIDMetadata(r)
+		}),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := customerpb.RegisterCustomerServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts); err != nil {
+		return nil, err
+	}
+	err = mux.HandlePath(http.MethodGet, "/openapi.json", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(openapi.CustomerSpec)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mux, nil
+}
+
+// gatewayHeaderMatcher passes the request id on to the gRPC server next to the
+// headers forwarded by default. The caller id and forwarded addresses are set
+// by the gateway alone, see callerIDMetadata.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, requestIDHeader) {
+		return requestIDHeader, true
+	}
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if !ok || strings.EqualFold(name, callerIDHeader) || strings.EqualFold(name, forwardedForHeader) {
+		return "", false
+	}
+	return name, true
+}
+
+// callerIDMetadata passes on the caller id of a request coming from a trusted
+// proxy.
+func (t trustedProxies) callerIDMetadata(r *http.Request) metadata.MD {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	id := r.Header.Get(callerIDHeader)
+	if err != nil || id == "" || !t.trusts(host) {
+		return nil
+	}
+	return metadata.Pairs(callerIDHeader, id)
+}
+
+// gatewayOutgoingHeaderMatcher returns the request id as a plain header, the
+// rest of the gRPC response headers get the usual Grpc-Metadata- prefix.
+func gatewayOutgoingHeaderMatcher(key string) (string, bool) {
+	if key == requestIDHeader {
+		return "X-Request-Id", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// setErrorHTTPStatus answers with the HTTP status matching an in-band error,
+// so REST clients see failures even when the gRPC call itself succeeded.
+func setErrorHTTPStatus(_ context.Context, w http.ResponseWriter, resp proto.Message) error {
+	withError, ok := resp.(errorResponse)
+	if !ok || withError.GetError() == nil {
+		return nil
+	}
+	e := withError.GetError()
+	if e.RetryAfterSeconds > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(int(e.RetryAfterSeconds)))
+	}
+	w.WriteHeader(runtime.HTTPStatusFromCode(convertErrorCodeToGRPC(e.Code)))
+	return nil
+}
@@ -0,0 +1,41 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/service/health"
+	"encoding/json"
+	"net/http"
+)
+
+type readiness struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+// NewProbeHandler serves the liveness probe at /healthz, which passes while
+// the process answers at all, and the readiness probe at /readyz, which fails
+// with 503 while a dependency check fails or the server shuts down.
+func NewProbeHandler(checker *health.Checker) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write([]byte("ok"))
+	})
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, _ *http.Request) {
+		body := readiness{Status: "ready", Checks: make(map[string]string)}
+		code := http.StatusOK
+		for name, err := range checker.Results() {
+			if err != nil {
+				body.Status = "not ready"
+				body.Checks[name] = err.Error()
+				code = http.StatusServiceUnavailable
+				continue
+			}
+			body.Checks[name] = "ok"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(body)
+	})
+	return mux
+}
@@ -0,0 +1,97 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+func (s *Server) ListFeedbacksForModeration(ctx context.Context, req *customerpb.ListFeedbacksForModerationRequest) (*customerpb.ListFeedbacksForModerationResponse, error) {
+	page := domain.Page{Limit: int(req.Limit), Offset: int(req.Offset), Token: req.PageToken}
+	feedbacks, count, nextPageToken, err := s.customerService.ListFeedbacksForModeration(ctx, convertFeedbackStatusToDomain(req.Status), req.CustomerId, page)
+	if err != nil {
+		return &customerpb.ListFeedbacksForModerationResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedbacks for moderation fetched", zap.Int("count", count))
+	return &customerpb.ListFeedbacksForModerationResponse{
+		Feedbacks:     gospadi.Map(feedbacks, convertFeedbackToProto),
+		Total:         int32(count),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) ModerateFeedback(ctx context.Context, req *customerpb.ModerateFeedbackRequest) (*customerpb.ModerateFeedbackResponse, error) {
+	if req.Id == "" {
+		return &customerpb.ModerateFeedbackResponse{
+			Error: newValidationError("id is required", "id"),
+		}, nil
+	}
+	if req.ModeratorId == "" {
+		return &customerpb.ModerateFeedbackResponse{
+			Error: newValidationError("moderator id is required", "moderator_id"),
+		}, nil
+	}
+	moderation := &domain.FeedbackModeration{
+		FeedbackID:  req.Id,
+		ModeratorID: req.ModeratorId,
+		Decision:    convertFeedbackStatusToDomain(req.Decision),
+		Reason:      req.Reason,
+	}
+	feedback, err := s.customerService.ModerateFeedback(ctx, moderation)
+	if err != nil {
+		return &customerpb.ModerateFeedbackResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedback moderated", zap.Any("moderation", moderation))
+	return &customerpb.ModerateFeedbackResponse{
+		Feedback: convertFeedbackToProto(feedback),
+	}, nil
+}
+
+func convertFeedbackStatusToDomain(status customerpb.FeedbackStatus) domain.FeedbackStatus {
+	switch status {
+	case customerpb.FeedbackStatus_FEEDBACK_STATUS_PENDING:
+		return domain.FeedbackStatusPending
+	case customerpb.FeedbackStatus_FEEDBACK_STATUS_PUBLISHED:
+		return domain.FeedbackStatusPublished
+	case customerpb.FeedbackStatus_FEEDBACK_STATUS_REJECTED:
+		return domain.FeedbackStatusRejected
+	case customerpb.FeedbackStatus_FEEDBACK_STATUS_HIDDEN:
+		return domain.FeedbackStatusHidden
+	}
+	return ""
+}
+
+func convertFeedbackStatusToProto(status domain.FeedbackStatus) customerpb.FeedbackStatus {
+	switch status {
+	case domain.FeedbackStatusPending:
+		return customerpb.FeedbackStatus_FEEDBACK_STATUS_PENDING
+	case domain.FeedbackStatusPublished:
+		return customerpb.FeedbackStatus_FEEDBACK_STATUS_PUBLISHED
+	case domain.FeedbackStatusRejected:
+		return customerpb.FeedbackStatus_FEEDBACK_STATUS_REJECTED
+	case domain.FeedbackStatusHidden:
+		return customerpb.FeedbackStatus_FEEDBACK_STATUS_HIDDEN
+	}
+	return customerpb.FeedbackStatus_FEEDBACK_STATUS_UNSPECIFIED
+}
+
+func convertAbuseAssessmentToProto(assessment *domain.AbuseAssessment) *customerpb.AbuseAssessment {
+	if assessment == nil {
+		return nil
+	}
+	return &customerpb.AbuseAssessment{
+		Score:      assessment.Score,
+		Velocity:   assessment.Velocity,
+		Burst:      assessment.Burst,
+		AccountAge: assessment.AccountAge,
+		Deviation:  assessment.Deviation,
+		Held:       assessment.Held,
+	}
+}
@@ -0,0 +1,164 @@
+package delivery
+
+import (
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"DobrikaDev/customer-service/internal/service/customer"
+	"DobrikaDev/customer-service/internal/service/ratelimit"
+	"DobrikaDev/customer-service/utils/config"
+	"DobrikaDev/customer-service/utils/logger"
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/netip"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// callerIDHeader names the calling client. Without it the caller is told
+// apart by its network address, the original one for calls coming through the
+// gateway or a trusted proxy.
+const (
+	callerIDHeader     = "x-caller-id"
+	forwardedForHeader = "x-forwarded-for"
+)
+
+// rateLimitedMethods are the calls throttled per caller, each with the way to
+// answer a refused call.
+var rateLimitedMethods = map[string]func(*customerpb.Error) any{
+	customerpb.CustomerService_CreateCustomer_FullMethodName: func(e *customerpb.Error) any {
+		return &customerpb.CreateCustomerResponse{Error: e}
+	},
+	customerpb.CustomerService_CreateFeedback_FullMethodName: func(e *customerpb.Error) any {
+		return &customerpb.CreateFeedbackResponse{Error: e}
+	},
+	customerpb.CustomerService_CreateVolunteerFeedback_FullMethodName: func(e *customerpb.Error) any {
+		return &customerpb.CreateVolunteerFeedbackResponse{Error: e}
+	},
+	customerpb.CustomerService_ReportCustomer_FullMethodName: func(e *customerpb.Error) any {
+		return &customerpb.ReportCustomerResponse{Error: e}
+	},
+	customerpb.CustomerService_ReportFeedback_FullMethodName: func(e *customerpb.Error) any {
+		return &customerpb.ReportFeedbackResponse{Error: e}
+	},
+}
+
+// RateLimitInterceptor throttles creation calls per caller before they reach
+// the service, which limits them per user on its own.
+func RateLimitInterceptor(limiter ratelimit.Limiter, cfg config.RateLimit, base *zap.Logger) (grpc.UnaryServerInterceptor, error) {
+	proxies, err := parseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		refuse, ok := rateLimitedMethods[info.FullMethod]
+		if !cfg.Enabled || !ok {
+			return handler(ctx, req)
+		}
+
+		log := logger.FromContext(ctx, base)
+		caller := proxies.callerIdentity(ctx)
+		// the service keys its own buckets on the caller where it has no user
+		ctx = ratelimit.WithCaller(ctx, caller)
+		decision, err := limiter.Allow(ctx, "caller:"+caller, cfg.Caller)
+		if err != nil {
+			log.Warn("rate limiter failed, letting request through", zap.Error(err), zap.String("caller", caller))
+			return handler(ctx, req)
+		}
+		if !decision.Allowed {
+			log.Info("caller rate limited", zap.String("caller", caller), zap.String("method", info.FullMethod))
+			return refuse(convertRateLimitToProto(decision.RetryAfter)), nil
+		}
+		return handler(ctx, req)
+	}, nil
+}
+
+// trustedProxies are the peers whose word on the caller is taken: the gateway
+// on loopback and the configured proxies. Anyone else could dodge the limit by
+// sending a new caller id or forwarded address with every call.
+type trustedProxies []netip.Prefix
+
+func parseTrustedProxies(entries []string) (trustedProxies, error) {
+	proxies := make(trustedProxies, 0, len(entries))
+	for _, entry := range entries {
+		if prefix, err := netip.ParsePrefix(entry); err == nil {
+			proxies = append(proxies, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+		}
+		proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+	}
+	return proxies, nil
+}
+
+func (t trustedProxies) trusts(host string) bool {
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	if addr.IsLoopback() {
+		return true
+	}
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// callerIdentity is the caller id or, failing that, the client address given
+// by a trusted peer, and the address of the peer itself otherwise.
+func (t trustedProxies) callerIdentity(ctx context.Context) string {
+	host := peerHost(ctx)
+	if !t.trusts(host) {
+		return host
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(callerIDHeader); len(ids) > 0 && ids[0] != "" {
+		return ids[0]
+	}
+	// every proxy appends the address it got the call from, so the client is
+	// the last address that is not one of our proxies
+	forwarded := md.Get(forwardedForHeader)
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hops := strings.Split(forwarded[i], ",")
+		for j := len(hops) - 1; j >= 0; j-- {
+			if hop := strings.TrimSpace(hops[j]); hop != "" && !t.trusts(hop) {
+				return hop
+			}
+		}
+	}
+	return host
+}
+
+func peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func convertRateLimitToProto(retryAfter time.Duration) *customerpb.Error {
+	return &customerpb.Error{
+		Code:              customerpb.ErrorCode_ERROR_CODE_RATE_LIMITED,
+		Message:           customer.ErrRateLimited.Error(),
+		RetryAfterSeconds: int32(max(1, math.Ceil(retryAfter.Seconds()))),
+	}
+}
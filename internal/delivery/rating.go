@@ -0,0 +1,50 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) GetCustomerRating(ctx context.Context, req *customerpb.GetCustomerRatingRequest) (*customerpb.GetCustomerRatingResponse, error) {
+	if req.CustomerId == "" {
+		return &customerpb.GetCustomerRatingResponse{
+			Error: newValidationError("customer id is required", "customer_id"),
+		}, nil
+	}
+	rating, err := s.customerService.GetCustomerRating(ctx, req.CustomerId)
+	if err != nil {
+		return &customerpb.GetCustomerRatingResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("customer rating fetched", zap.Any("rating", rating))
+	return &customerpb.GetCustomerRatingResponse{
+		Rating: convertCustomerRatingToProto(rating),
+	}, nil
+}
+
+func convertCustomerRatingToProto(rating *domain.CustomerRating) *customerpb.CustomerRating {
+	distribution := make(map[int32]int32, len(rating.Distribution))
+	for stars, count := range rating.Distribution {
+		distribution[int32(stars)] = int32(count)
+	}
+	criteria := make([]*customerpb.CriterionRating, 0, len(rating.Criteria))
+	for _, criterion := range rating.Criteria {
+		criteria = append(criteria, &customerpb.CriterionRating{
+			Criterion: criterion.Criterion,
+			Average:   criterion.Average(),
+			Count:     int32(criterion.Count),
+		})
+	}
+	return &customerpb.CustomerRating{
+		CustomerId:   rating.CustomerID,
+		Average:      rating.Average(),
+		Count:        int32(rating.Count),
+		Distribution: distribution,
+		UpdatedAt:    int32(rating.UpdatedAt.Unix()),
+		Criteria:     criteria,
+	}
+}
@@ -0,0 +1,99 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+
+	"go.uber.org/zap"
+)
+
+func (s *Server) ReplyToFeedback(ctx context.Context, req *customerpb.ReplyToFeedbackRequest) (*customerpb.ReplyToFeedbackResponse, error) {
+	if err := validateFeedbackReplyRequest(req.FeedbackId, req.CustomerId, req.Text); err != nil {
+		return &customerpb.ReplyToFeedbackResponse{Error: err}, nil
+	}
+	reply, err := s.customerService.ReplyToFeedback(ctx, &domain.FeedbackReply{
+		FeedbackID: req.FeedbackId,
+		CustomerID: req.CustomerId,
+		Text:       req.Text,
+	})
+	if err != nil {
+		return &customerpb.ReplyToFeedbackResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedback reply created", zap.Any("reply", reply))
+	return &customerpb.ReplyToFeedbackResponse{
+		Reply: convertFeedbackReplyToProto(reply),
+	}, nil
+}
+
+func (s *Server) UpdateFeedbackReply(ctx context.Context, req *customerpb.UpdateFeedbackReplyRequest) (*customerpb.UpdateFeedbackReplyResponse, error) {
+	if err := validateFeedbackReplyRequest(req.FeedbackId, req.CustomerId, req.Text); err != nil {
+		return &customerpb.UpdateFeedbackReplyResponse{Error: err}, nil
+	}
+	reply, err := s.customerService.UpdateFeedbackReply(ctx, &domain.FeedbackReply{
+		FeedbackID: req.FeedbackId,
+		CustomerID: req.CustomerId,
+		Text:       req.Text,
+	})
+	if err != nil {
+		return &customerpb.UpdateFeedbackReplyResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedback reply updated", zap.Any("reply", reply))
+	return &customerpb.UpdateFeedbackReplyResponse{
+		Reply: convertFeedbackReplyToProto(reply),
+	}, nil
+}
+
+func (s *Server) DeleteFeedbackReply(ctx context.Context, req *customerpb.DeleteFeedbackReplyRequest) (*customerpb.DeleteFeedbackReplyResponse, error) {
+	if req.FeedbackId == "" {
+		return &customerpb.DeleteFeedbackReplyResponse{
+			Error: newValidationError("feedback id is required", "feedback_id"),
+		}, nil
+	}
+	if req.CustomerId == "" {
+		return &customerpb.DeleteFeedbackReplyResponse{
+			Error: newValidationError("customer id is required", "customer_id"),
+		}, nil
+	}
+	err := s.customerService.DeleteFeedbackReply(ctx, req.CustomerId, req.FeedbackId)
+	if err != nil {
+		return &customerpb.DeleteFeedbackReplyResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedback reply deleted", zap.String("feedback_id", req.FeedbackId))
+	return &customerpb.DeleteFeedbackReplyResponse{
+		FeedbackId: req.FeedbackId,
+	}, nil
+}
+
+func validateFeedbackReplyRequest(feedbackID string, customerID string, text string) *customerpb.Error {
+	switch {
+	case feedbackID == "":
+		return newValidationError("feedback id is required", "feedback_id")
+	case customerID == "":
+		return newValidationError("customer id is required", "customer_id")
+	case text == "":
+		return newValidationError("text is required", "text")
+	}
+	return nil
+}
+
+func convertFeedbackReplyToProto(reply *domain.FeedbackReply) *customerpb.FeedbackReply {
+	if reply == nil {
+		return nil
+	}
+	return &customerpb.FeedbackReply{
+		Id:         reply.ID,
+		FeedbackId: reply.FeedbackID,
+		CustomerId: reply.CustomerID,
+		Text:       reply.Text,
+		CreatedAt:  int32(reply.CreatedAt.Unix()),
+		UpdatedAt:  int32(reply.UpdatedAt.Unix()),
+		Screening:  convertScreeningVerdictToProto(reply.Screening),
+	}
+}
@@ -0,0 +1,216 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+	"strings"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+func (s *Server) ReportCustomer(ctx context.Context, req *customerpb.ReportCustomerRequest) (*customerpb.ReportCustomerResponse, error) {
+	if err := validateReportRequest("max_id", req.MaxId, req.ReporterId, req.Reason); err != nil {
+		return &customerpb.ReportCustomerResponse{Error: err}, nil
+	}
+	report, err := s.customerService.ReportCustomer(ctx, &domain.Report{
+		TargetID:   req.MaxId,
+		ReporterID: req.ReporterId,
+		Reason:     convertReportReasonToDomain(req.Reason),
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		return &customerpb.ReportCustomerResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("customer reported", zap.Any("report", report))
+	return &customerpb.ReportCustomerResponse{
+		Report: convertReportToProto(report),
+	}, nil
+}
+
+func (s *Server) ReportFeedback(ctx context.Context, req *customerpb.ReportFeedbackRequest) (*customerpb.ReportFeedbackResponse, error) {
+	if err := validateReportRequest("feedback_id", req.FeedbackId, req.ReporterId, req.Reason); err != nil {
+		return &customerpb.ReportFeedbackResponse{Error: err}, nil
+	}
+	report, err := s.customerService.ReportFeedback(ctx, &domain.Report{
+		TargetID:   req.FeedbackId,
+		ReporterID: req.ReporterId,
+		Reason:     convertReportReasonToDomain(req.Reason),
+		Comment:    req.Comment,
+	})
+	if err != nil {
+		return &customerpb.ReportFeedbackResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("feedback reported", zap.Any("report", report))
+	return &customerpb.ReportFeedbackResponse{
+		Report: convertReportToProto(report),
+	}, nil
+}
+
+func (s *Server) ListReports(ctx context.Context, req *customerpb.ListReportsRequest) (*customerpb.ListReportsResponse, error) {
+	filter := &domain.ReportFilter{
+		TargetType: convertReportTargetToDomain(req.Target),
+		TargetID:   req.TargetId,
+		Status:     convertReportStatusToDomain(req.Status),
+		Reason:     convertReportReasonToDomain(req.Reason),
+	}
+	page := domain.Page{Limit: int(req.Limit), Offset: int(req.Offset), Token: req.PageToken}
+	reports, count, nextPageToken, err := s.customerService.ListReports(ctx, filter, page)
+	if err != nil {
+		return &customerpb.ListReportsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("reports fetched", zap.Any("filter", filter), zap.Int("count", count))
+	return &customerpb.ListReportsResponse{
+		Reports:       gospadi.Map(reports, convertReportToProto),
+		Total:         int32(count),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) ResolveReports(ctx context.Context, req *customerpb.ResolveReportsRequest) (*customerpb.ResolveReportsResponse, error) {
+	var validationErr *customerpb.Error
+	switch {
+	case req.Target == customerpb.ReportTarget_REPORT_TARGET_UNSPECIFIED:
+		validationErr = newValidationError("target is required", "target")
+	case req.TargetId == "":
+		validationErr = newValidationError("target id is required", "target_id")
+	case req.ResolverId == "":
+		validationErr = newValidationError("resolver id is required", "resolver_id")
+	case req.Decision != customerpb.ReportStatus_REPORT_STATUS_UPHELD && req.Decision != customerpb.ReportStatus_REPORT_STATUS_DISMISSED:
+		validationErr = newValidationError("decision must be upheld or dismissed", "decision")
+	}
+	if validationErr != nil {
+		return &customerpb.ResolveReportsResponse{Error: validationErr}, nil
+	}
+	resolution := &domain.ReportResolution{
+		TargetType: convertReportTargetToDomain(req.Target),
+		TargetID:   req.TargetId,
+		Decision:   convertReportStatusToDomain(req.Decision),
+		ResolverID: req.ResolverId,
+		Note:       req.Note,
+	}
+	reports, err := s.customerService.ResolveReports(ctx, resolution)
+	if err != nil {
+		return &customerpb.ResolveReportsResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("reports resolved", zap.Any("resolution", resolution), zap.Int("count", len(reports)))
+	return &customerpb.ResolveReportsResponse{
+		Reports: gospadi.Map(reports, convertReportToProto),
+	}, nil
+}
+
+func validateReportRequest(targetField string, targetID string, reporterID string, reason customerpb.ReportReason) *customerpb.Error {
+	switch {
+	case targetID == "":
+		return newValidationError(strings.ReplaceAll(targetField, "_", " ")+" is required", targetField)
+	case reporterID == "":
+		return newValidationError("reporter id is required", "reporter_id")
+	case reason == customerpb.ReportReason_REPORT_REASON_UNSPECIFIED:
+		return newValidationError("reason is required", "reason")
+	}
+	return nil
+}
+
+func convertReportToProto(report *domain.Report) *customerpb.Report {
+	pb := &customerpb.Report{
+		Id:             report.ID,
+		Target:         convertReportTargetToProto(report.TargetType),
+		TargetId:       report.TargetID,
+		ReporterId:     report.ReporterID,
+		Reason:         convertReportReasonToProto(report.Reason),
+		Comment:        report.Comment,
+		Status:         convertReportStatusToProto(report.Status),
+		ResolvedBy:     report.ResolvedBy,
+		ResolutionNote: report.ResolutionNote,
+		CreatedAt:      int32(report.CreatedAt.Unix()),
+	}
+	if report.ResolvedAt != nil {
+		pb.ResolvedAt = int32(report.ResolvedAt.Unix())
+	}
+	return pb
+}
+
+func convertReportTargetToDomain(target customerpb.ReportTarget) domain.ReportTarget {
+	switch target {
+	case customerpb.ReportTarget_REPORT_TARGET_CUSTOMER:
+		return domain.ReportTargetCustomer
+	case customerpb.ReportTarget_REPORT_TARGET_FEEDBACK:
+		return domain.ReportTargetFeedback
+	}
+	return ""
+}
+
+func convertReportTargetToProto(target domain.ReportTarget) customerpb.ReportTarget {
+	switch target {
+	case domain.ReportTargetCustomer:
+		return customerpb.ReportTarget_REPORT_TARGET_CUSTOMER
+	case domain.ReportTargetFeedback:
+		return customerpb.ReportTarget_REPORT_TARGET_FEEDBACK
+	}
+	return customerpb.ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func convertReportReasonToDomain(reason customerpb.ReportReason) domain.ReportReason {
+	switch reason {
+	case customerpb.ReportReason_REPORT_REASON_SPAM:
+		return domain.ReportReasonSpam
+	case customerpb.ReportReason_REPORT_REASON_FRAUD:
+		return domain.ReportReasonFraud
+	case customerpb.ReportReason_REPORT_REASON_OFFENSIVE:
+		return domain.ReportReasonOffensive
+	case customerpb.ReportReason_REPORT_REASON_FAKE:
+		return domain.ReportReasonFake
+	case customerpb.ReportReason_REPORT_REASON_OTHER:
+		return domain.ReportReasonOther
+	}
+	return ""
+}
+
+func convertReportReasonToProto(reason domain.ReportReason) customerpb.ReportReason {
+	switch reason {
+	case domain.ReportReasonSpam:
+		return customerpb.ReportReason_REPORT_REASON_SPAM
+	case domain.ReportReasonFraud:
+		return customerpb.ReportReason_REPORT_REASON_FRAUD
+	case domain.ReportReasonOffensive:
+		return customerpb.ReportReason_REPORT_REASON_OFFENSIVE
+	case domain.ReportReasonFake:
+		return customerpb.ReportReason_REPORT_REASON_FAKE
+	case domain.ReportReasonOther:
+		return customerpb.ReportReason_REPORT_REASON_OTHER
+	}
+	return customerpb.ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func convertReportStatusToDomain(status customerpb.ReportStatus) domain.ReportStatus {
+	switch status {
+	case customerpb.ReportStatus_REPORT_STATUS_OPEN:
+		return domain.ReportStatusOpen
+	case customerpb.ReportStatus_REPORT_STATUS_UPHELD:
+		return domain.ReportStatusUpheld
+	case customerpb.ReportStatus_REPORT_STATUS_DISMISSED:
+		return domain.ReportStatusDismissed
+	}
+	return ""
+}
+
+func convertReportStatusToProto(status domain.ReportStatus) customerpb.ReportStatus {
+	switch status {
+	case domain.ReportStatusOpen:
+		return customerpb.ReportStatus_REPORT_STATUS_OPEN
+	case domain.ReportStatusUpheld:
+		return customerpb.ReportStatus_REPORT_STATUS_UPHELD
+	case domain.ReportStatusDismissed:
+		return customerpb.ReportStatus_REPORT_STATUS_DISMISSED
+	}
+	return customerpb.ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
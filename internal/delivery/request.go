@@ -0,0 +1,133 @@
+package delivery
+
+import (
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"DobrikaDev/customer-service/utils/logger"
+	"context"
+	"regexp"
+	"runtime/debug"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// requestIDHeader correlates the log lines of one call. It is taken from the
+// caller when given and sent back in the response header either way.
+const requestIDHeader = "x-request-id"
+
+// requestIDPattern limits the ids taken from callers, so that they cannot
+// forge log lines or blow up log volume. Others are replaced by a new id.
+var requestIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+// RequestIDInterceptor attaches a logger carrying the request id and method
+// to the context of the call, see logger.FromContext.
+func RequestIDInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		id := requestID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, id))
+
+		ctx = logger.WithContext(ctx, base.With(
+			zap.String("request_id", id),
+			zap.String("method", info.FullMethod),
+		))
+		return handler(ctx, req)
+	}
+}
+
+func requestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if ids := md.Get(requestIDHeader); len(ids) > 0 && requestIDPattern.MatchString(ids[0]) {
+			return ids[0]
+		}
+	}
+	return uuid.NewString()
+}
+
+// AccessLogInterceptor logs every call with its duration and code. Errors
+// reported in-band count with the code they would have as a gRPC status.
+func AccessLogInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+
+		code := status.Code(err)
+		fields := []zap.Field{zap.Duration("duration", time.Since(start))}
+		if withError, ok := resp.(errorResponse); ok && err == nil && withError.GetError() != nil {
+			code = convertErrorCodeToGRPC(withError.GetError().Code)
+			fields = append(fields, zap.String("error_code", withError.GetError().Code.String()))
+		}
+		fields = append(fields, zap.String("code", code.String()))
+
+		log := logger.FromContext(ctx, base)
+		switch code {
+		case codes.Internal, codes.Unknown, codes.DataLoss:
+			log.Error("request failed", fields...)
+		default:
+			log.Info("request handled", fields...)
+		}
+		return resp, err
+	}
+}
+
+// RecoveryInterceptor turns a panic in a handler into an internal error
+// instead of crashing the server. The stack trace is only logged.
+func RecoveryInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			recovered := recover()
+			if recovered == nil {
+				return
+			}
+			logger.FromContext(ctx, base).Error("handler panicked",
+				zap.Any("panic", recovered),
+				zap.ByteString("stack", debug.Stack()),
+			)
+
+			internal := &customerpb.Error{
+				Code:    customerpb.ErrorCode_ERROR_CODE_INTERNAL,
+				Message: "internal error",
+			}
+			if withError, ok := newErrorResponse(info.FullMethod, internal); ok {
+				resp, err = withError, nil
+				return
+			}
+			resp, err = nil, status.Error(codes.Internal, internal.Message)
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+// newErrorResponse builds the response of method carrying only e, for methods
+// whose response has an in-band error.
+func newErrorResponse(method string, e *customerpb.Error) (any, bool) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, false
+	}
+	methodDesc, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, false
+	}
+	respType, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Output().FullName())
+	if err != nil {
+		return nil, false
+	}
+
+	resp := respType.New()
+	field := resp.Descriptor().Fields().ByName("error")
+	if field == nil || field.Message() == nil || field.Message().FullName() != e.ProtoReflect().Descriptor().FullName() {
+		return nil, false
+	}
+	resp.Set(field, protoreflect.ValueOfMessage(e.ProtoReflect()))
+	return resp.Interface(), true
+}
@@ -0,0 +1,51 @@
+package delivery
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		ids    []string
+		want   string
+		wantOK bool
+	}{
+		{name: "caller id", ids: []string{"req-1.a_B"}, want: "req-1.a_B", wantOK: true},
+		{name: "longest caller id", ids: []string{strings.Repeat("a", 64)}, want: strings.Repeat("a", 64), wantOK: true},
+		{name: "first of several", ids: []string{"first", "second"}, want: "first", wantOK: true},
+		{name: "no header"},
+		{name: "empty", ids: []string{""}},
+		{name: "too long", ids: []string{strings.Repeat("a", 65)}},
+		{name: "line break", ids: []string{"req-1\nlevel=error"}},
+		{name: "spaces", ids: []string{"req 1"}},
+		{name: "non ascii", ids: []string{"запрос"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ids != nil {
+				md := metadata.MD{}
+				md.Append(requestIDHeader, tt.ids...)
+				ctx = metadata.NewIncomingContext(ctx, md)
+			}
+
+			got := requestID(ctx)
+			if tt.wantOK {
+				if got != tt.want {
+					t.Errorf("requestID() = %q, want %q", got, tt.want)
+				}
+				return
+			}
+			if _, err := uuid.Parse(got); err != nil {
+				t.Errorf("requestID() = %q, want a new uuid", got)
+			}
+		})
+	}
+}
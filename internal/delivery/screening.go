@@ -0,0 +1,48 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+)
+
+func convertScreeningVerdictToProto(verdict *domain.ScreeningVerdict) *customerpb.ScreeningVerdict {
+	if verdict == nil {
+		return nil
+	}
+	categories := make([]customerpb.ScreeningCategory, 0, len(verdict.Categories))
+	for _, category := range verdict.Categories {
+		categories = append(categories, convertScreeningCategoryToProto(category))
+	}
+	return &customerpb.ScreeningVerdict{
+		Action:     convertScreeningActionToProto(verdict.Action),
+		Categories: categories,
+	}
+}
+
+func convertScreeningActionToProto(action domain.ScreeningAction) customerpb.ScreeningAction {
+	switch action {
+	case domain.ScreeningActionAllow:
+		return customerpb.ScreeningAction_SCREENING_ACTION_ALLOW
+	case domain.ScreeningActionMask:
+		return customerpb.ScreeningAction_SCREENING_ACTION_MASK
+	case domain.ScreeningActionModerate:
+		return customerpb.ScreeningAction_SCREENING_ACTION_MODERATE
+	case domain.ScreeningActionReject:
+		return customerpb.ScreeningAction_SCREENING_ACTION_REJECT
+	}
+	return customerpb.ScreeningAction_SCREENING_ACTION_UNSPECIFIED
+}
+
+func convertScreeningCategoryToProto(category domain.ScreeningCategory) customerpb.ScreeningCategory {
+	switch category {
+	case domain.ScreeningCategoryObscene:
+		return customerpb.ScreeningCategory_SCREENING_CATEGORY_OBSCENE
+	case domain.ScreeningCategoryContact:
+		return customerpb.ScreeningCategory_SCREENING_CATEGORY_CONTACT
+	case domain.ScreeningCategoryLink:
+		return customerpb.ScreeningCategory_SCREENING_CATEGORY_LINK
+	case domain.ScreeningCategorySpam:
+		return customerpb.ScreeningCategory_SCREENING_CATEGORY_SPAM
+	}
+	return customerpb.ScreeningCategory_SCREENING_CATEGORY_UNSPECIFIED
+}
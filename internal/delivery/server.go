@@ -4,6 +4,7 @@ import (
 	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
 	"DobrikaDev/customer-service/internal/service/customer"
 	"DobrikaDev/customer-service/utils/config"
+	"DobrikaDev/customer-service/utils/logger"
 	"context"
 
 	"go.uber.org/zap"
@@ -26,3 +27,8 @@ func NewServer(ctx context.Context, customerService *customer.CustomerService, c
 func (s *Server) Register(grpcServer *grpc.Server) {
 	customerpb.RegisterCustomerServiceServer(grpcServer, s)
 }
+
+// log returns the request-scoped logger of ctx.
+func (s *Server) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, s.logger)
+}
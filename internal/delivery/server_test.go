@@ -0,0 +1,41 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/service/abuse"
+	"DobrikaDev/customer-service/internal/service/customer"
+	"DobrikaDev/customer-service/internal/service/taskverifier"
+	"DobrikaDev/customer-service/internal/storage/deps"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"DobrikaDev/customer-service/internal/storage/sqlxtrm"
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// newTestServer returns a server backed by the real service and storage on a
+// mocked database, which expects exactly the queries a test sets up.
+func newTestServer(t *testing.T, cfg *config.Config) (*Server, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet database expectations: %v", err)
+		}
+		db.Close()
+	})
+
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+	trf := sqlxtrm.NewSqlxTransactionFactory(sqlx.NewDb(db, "pgx"))
+	storage := sql.NewStorage(trf, deps.NewTrmStub(), zap.NewNop())
+	service := customer.NewCustomerService(storage, nil, nil, nil, taskverifier.NewAllowAllVerifier(), abuse.NewDetector(cfg.Abuse), nil, cfg, zap.NewNop())
+	return NewServer(context.Background(), service, cfg, zap.NewNop()), mock
+}
@@ -0,0 +1,136 @@
+package delivery
+
+import (
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	ErrorModeInband = "inband"
+	ErrorModeDual   = "dual"
+	ErrorModeStatus = "status"
+)
+
+// errorStatusTrailer carries the google.rpc.Status of a failed call in dual
+// mode, where the call itself still succeeds.
+const errorStatusTrailer = "error-status-bin"
+
+// errorDomain is the ErrorInfo domain of errors raised by this service.
+const errorDomain = "customer-service"
+
+type errorResponse interface {
+	GetError() *customerpb.Error
+}
+
+// ErrorStatusInterceptor reports the in-band error of a response as a gRPC
+// status according to mode; an empty mode means dual.
+func ErrorStatusInterceptor(mode string) (grpc.UnaryServerInterceptor, error) {
+	switch mode {
+	case "":
+		mode = ErrorModeDual
+	case ErrorModeInband, ErrorModeDual, ErrorModeStatus:
+	default:
+		return nil, fmt.Errorf("unknown error mode %q", mode)
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil || mode == ErrorModeInband {
+			return resp, err
+		}
+		withError, ok := resp.(errorResponse)
+		if !ok || withError.GetError() == nil {
+			return resp, nil
+		}
+
+		st := convertErrorToStatus(withError.GetError())
+		if mode == ErrorModeStatus {
+			return nil, st.Err()
+		}
+		if encoded, err := proto.Marshal(st.Proto()); err == nil {
+			_ = grpc.SetTrailer(ctx, metadata.Pairs(errorStatusTrailer, string(encoded)))
+		}
+		return resp, nil
+	}, nil
+}
+
+func newValidationError(message string, fields ...string) *customerpb.Error {
+	violations := make([]*customerpb.FieldViolation, 0, len(fields))
+	for _, field := range fields {
+		violations = append(violations, &customerpb.FieldViolation{Field: field, Description: message})
+	}
+	return &customerpb.Error{
+		Code:       customerpb.ErrorCode_ERROR_CODE_VALIDATION,
+		Message:    message,
+		Violations: violations,
+	}
+}
+
+// convertErrorToStatus turns an in-band error into a gRPC status with
+// ErrorInfo, and BadRequest or RetryInfo details where they apply.
+func convertErrorToStatus(e *customerpb.Error) *status.Status {
+	st := status.New(convertErrorCodeToGRPC(e.Code), e.Message)
+
+	info := &errdetails.ErrorInfo{Reason: e.Code.String(), Domain: errorDomain}
+	if e.RetryAfterSeconds > 0 {
+		info.Metadata = map[string]string{"retry_after_seconds": strconv.Itoa(int(e.RetryAfterSeconds))}
+	}
+	details := []protoadapt.MessageV1{info}
+	if len(e.Violations) > 0 {
+		badRequest := &errdetails.BadRequest{}
+		for _, violation := range e.Violations {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			})
+		}
+		details = append(details, badRequest)
+	}
+	if e.RetryAfterSeconds > 0 {
+		details = append(details, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(time.Duration(e.RetryAfterSeconds) * time.Second),
+		})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+	return withDetails
+}
+
+func convertErrorCodeToGRPC(code customerpb.ErrorCode) codes.Code {
+	switch code {
+	case customerpb.ErrorCode_ERROR_CODE_VALIDATION:
+		return codes.InvalidArgument
+	case customerpb.ErrorCode_ERROR_CODE_NOT_FOUND:
+		return codes.NotFound
+	case customerpb.ErrorCode_ERROR_CODE_INTERNAL:
+		return codes.Internal
+	case customerpb.ErrorCode_ERROR_CODE_ALREADY_EXISTS:
+		return codes.AlreadyExists
+	case customerpb.ErrorCode_ERROR_CODE_NOT_ENOUGH:
+		return codes.FailedPrecondition
+	case customerpb.ErrorCode_ERROR_CODE_CONFLICT:
+		return codes.Aborted
+	case customerpb.ErrorCode_ERROR_CODE_FORBIDDEN:
+		return codes.PermissionDenied
+	case customerpb.ErrorCode_ERROR_CODE_UNAVAILABLE:
+		return codes.Unavailable
+	case customerpb.ErrorCode_ERROR_CODE_RATE_LIMITED:
+		return codes.ResourceExhausted
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,153 @@
+package delivery
+
+import (
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// trailerStream records the trailer a handler sets.
+type trailerStream struct {
+	grpc.ServerTransportStream
+	trailer metadata.MD
+}
+
+func (s *trailerStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestErrorStatusInterceptor(t *testing.T) {
+	failed := &customerpb.CreateCustomerResponse{Error: newValidationError("max id is required", "Customer.max_id")}
+	succeeded := &customerpb.CreateCustomerResponse{}
+
+	tests := []struct {
+		name        string
+		mode        string
+		resp        *customerpb.CreateCustomerResponse
+		wantCode    codes.Code
+		wantInBand  bool
+		wantTrailer bool
+	}{
+		{name: "inband keeps the error in the response", mode: ErrorModeInband, resp: failed, wantInBand: true},
+		{name: "dual keeps the response and adds the status trailer", mode: ErrorModeDual, resp: failed, wantInBand: true, wantTrailer: true},
+		{name: "empty mode is dual", mode: "", resp: failed, wantInBand: true, wantTrailer: true},
+		{name: "status fails the call", mode: ErrorModeStatus, resp: failed, wantCode: codes.InvalidArgument},
+		{name: "success untouched", mode: ErrorModeStatus, resp: succeeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			interceptor, err := ErrorStatusInterceptor(tt.mode)
+			if err != nil {
+				t.Fatalf("ErrorStatusInterceptor() error = %v", err)
+			}
+			stream := &trailerStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			handler := func(ctx context.Context, req any) (any, error) { return tt.resp, nil }
+
+			resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("interceptor code = %v, want %v", got, tt.wantCode)
+			}
+			if tt.wantCode != codes.OK {
+				assertViolation(t, status.Convert(err).Proto(), "Customer.max_id")
+				return
+			}
+			withError, _ := resp.(errorResponse)
+			if inBand := withError != nil && withError.GetError() != nil; inBand != tt.wantInBand {
+				t.Errorf("in-band error present = %v, want %v", inBand, tt.wantInBand)
+			}
+			values := stream.trailer.Get(errorStatusTrailer)
+			if (len(values) > 0) != tt.wantTrailer {
+				t.Fatalf("status trailer present = %v, want %v", len(values) > 0, tt.wantTrailer)
+			}
+			if tt.wantTrailer {
+				var st spb.Status
+				if err := proto.Unmarshal([]byte(values[0]), &st); err != nil {
+					t.Fatalf("trailer is not a google.rpc.Status: %v", err)
+				}
+				if codes.Code(st.Code) != codes.InvalidArgument {
+					t.Errorf("trailer code = %v, want %v", codes.Code(st.Code), codes.InvalidArgument)
+				}
+				assertViolation(t, &st, "Customer.max_id")
+			}
+		})
+	}
+}
+
+func TestErrorStatusInterceptorUnknownMode(t *testing.T) {
+	if _, err := ErrorStatusInterceptor("loud"); err == nil {
+		t.Fatal("ErrorStatusInterceptor() error = nil, want unknown mode error")
+	}
+}
+
+func TestValidationFieldPaths(t *testing.T) {
+	server, _ := newTestServer(t, nil)
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		call func() (errorResponse, error)
+		want string
+	}{
+		{
+			name: "missing customer",
+			call: func() (errorResponse, error) {
+				return server.CreateCustomer(ctx, &customerpb.CreateCustomerRequest{})
+			},
+			want: "Customer",
+		},
+		{
+			name: "missing customer max id",
+			call: func() (errorResponse, error) {
+				return server.CreateCustomer(ctx, &customerpb.CreateCustomerRequest{Customer: &customerpb.Customer{Name: "Fund"}})
+			},
+			want: "Customer.max_id",
+		},
+		{
+			name: "missing feedback",
+			call: func() (errorResponse, error) {
+				return server.CreateFeedback(ctx, &customerpb.CreateFeedbackRequest{})
+			},
+			want: "Feedback",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := tt.call()
+			if err != nil {
+				t.Fatalf("call error = %v", err)
+			}
+			violations := resp.GetError().GetViolations()
+			if len(violations) != 1 || violations[0].Field != tt.want {
+				t.Errorf("violations = %v, want field %q", violations, tt.want)
+			}
+		})
+	}
+}
+
+func assertViolation(t *testing.T, st *spb.Status, field string) {
+	t.Helper()
+	for _, detail := range st.Details {
+		var badRequest errdetails.BadRequest
+		if detail.UnmarshalTo(&badRequest) != nil {
+			continue
+		}
+		for _, violation := range badRequest.FieldViolations {
+			if violation.Field == field {
+				return
+			}
+		}
+	}
+	t.Errorf("status details %v have no violation of %q", st.Details, field)
+}
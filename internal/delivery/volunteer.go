@@ -0,0 +1,123 @@
+package delivery
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
+	"context"
+
+	"github.com/dr3dnought/gospadi"
+	"go.uber.org/zap"
+)
+
+func (s *Server) CreateVolunteerFeedback(ctx context.Context, req *customerpb.CreateVolunteerFeedbackRequest) (*customerpb.CreateVolunteerFeedbackResponse, error) {
+	if req.Feedback == nil {
+		return &customerpb.CreateVolunteerFeedbackResponse{
+			Error: newValidationError("feedback is required", "Feedback"),
+		}, nil
+	}
+	feedback, err := s.customerService.CreateVolunteerFeedback(ctx, &domain.VolunteerFeedback{
+		CustomerID: req.Feedback.CustomerId,
+		UserID:     req.Feedback.UserId,
+		TaskID:     req.Feedback.TaskId,
+		Rating:     int(req.Feedback.Rating),
+		Comment:    req.Feedback.Comment,
+	})
+	if err != nil {
+		return &customerpb.CreateVolunteerFeedbackResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("volunteer feedback created", zap.Any("feedback", feedback))
+	return &customerpb.CreateVolunteerFeedbackResponse{
+		Feedback: convertVolunteerFeedbackToProto(feedback),
+	}, nil
+}
+
+func (s *Server) GetVolunteerFeedbacks(ctx context.Context, req *customerpb.GetVolunteerFeedbacksRequest) (*customerpb.GetVolunteerFeedbacksResponse, error) {
+	if req.UserId == "" && req.CustomerId == "" && req.TaskId == "" {
+		return &customerpb.GetVolunteerFeedbacksResponse{
+			Error: newValidationError("user id, customer id or task id is required", "user_id", "customer_id", "task_id"),
+		}, nil
+	}
+	filter := &domain.VolunteerFeedbackFilter{
+		UserID:     req.UserId,
+		CustomerID: req.CustomerId,
+		TaskID:     req.TaskId,
+	}
+	page := domain.Page{Limit: int(req.Limit), Offset: int(req.Offset), Token: req.PageToken}
+	feedbacks, count, nextPageToken, err := s.customerService.GetVolunteerFeedbacks(ctx, filter, page)
+	if err != nil {
+		return &customerpb.GetVolunteerFeedbacksResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("volunteer feedbacks fetched", zap.Any("filter", filter), zap.Int("count", count))
+	return &customerpb.GetVolunteerFeedbacksResponse{
+		Feedbacks:     gospadi.Map(feedbacks, convertVolunteerFeedbackToProto),
+		Total:         int32(count),
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (s *Server) GetVolunteerFeedbackByID(ctx context.Context, req *customerpb.GetVolunteerFeedbackByIDRequest) (*customerpb.GetVolunteerFeedbackByIDResponse, error) {
+	if req.Id == "" {
+		return &customerpb.GetVolunteerFeedbackByIDResponse{
+			Error: newValidationError("id is required", "id"),
+		}, nil
+	}
+	feedback, err := s.customerService.GetVolunteerFeedbackByID(ctx, req.Id)
+	if err != nil {
+		return &customerpb.GetVolunteerFeedbackByIDResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("volunteer feedback fetched", zap.Any("feedback", feedback))
+	return &customerpb.GetVolunteerFeedbackByIDResponse{
+		Feedback: convertVolunteerFeedbackToProto(feedback),
+	}, nil
+}
+
+func (s *Server) GetVolunteerRating(ctx context.Context, req *customerpb.GetVolunteerRatingRequest) (*customerpb.GetVolunteerRatingResponse, error) {
+	if req.UserId == "" {
+		return &customerpb.GetVolunteerRatingResponse{
+			Error: newValidationError("user id is required", "user_id"),
+		}, nil
+	}
+	rating, err := s.customerService.GetVolunteerRating(ctx, req.UserId)
+	if err != nil {
+		return &customerpb.GetVolunteerRatingResponse{
+			Error: convertErrorToProto(err),
+		}, nil
+	}
+	s.log(ctx).Info("volunteer rating fetched", zap.Any("rating", rating))
+	return &customerpb.GetVolunteerRatingResponse{
+		Rating: convertVolunteerRatingToProto(rating),
+	}, nil
+}
+
+func convertVolunteerFeedbackToProto(feedback *domain.VolunteerFeedback) *customerpb.VolunteerFeedback {
+	return &customerpb.VolunteerFeedback{
+		Id:         feedback.ID,
+		CustomerId: feedback.CustomerID,
+		UserId:     feedback.UserID,
+		TaskId:     feedback.TaskID,
+		Rating:     int32(feedback.Rating),
+		Comment:    feedback.Comment,
+		CreatedAt:  int32(feedback.CreatedAt.Unix()),
+		UpdatedAt:  int32(feedback.UpdatedAt.Unix()),
+		Screening:  convertScreeningVerdictToProto(feedback.Screening),
+	}
+}
+
+func convertVolunteerRatingToProto(rating *domain.VolunteerRating) *customerpb.VolunteerRating {
+	distribution := make(map[int32]int32, len(rating.Distribution))
+	for stars, count := range rating.Distribution {
+		distribution[int32(stars)] = int32(count)
+	}
+	return &customerpb.VolunteerRating{
+		UserId:       rating.UserID,
+		Average:      rating.Average(),
+		Count:        int32(rating.Count),
+		Distribution: distribution,
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// AbuseSignals are the raw facts a new feedback is judged on.
+type AbuseSignals struct {
+	// UserFeedbacks and CustomerFeedbacks count the feedback written recently
+	// by the same user and against the same customer, the new one included.
+	UserFeedbacks     int
+	CustomerFeedbacks int
+	AccountAge        time.Duration
+	// UnknownAccount is set when the user's registration time is not known;
+	// AccountAge then says nothing and is not scored.
+	UnknownAccount bool
+	Rating         int
+	CustomerRating *CustomerRating
+}
+
+// AbuseAssessment is the abuse score of a feedback together with the 0-1
+// components it was built from.
+type AbuseAssessment struct {
+	FeedbackID string    `json:"feedback_id" db:"feedback_id"`
+	Score      float64   `json:"score" db:"score"`
+	Velocity   float64   `json:"velocity" db:"velocity"`
+	Burst      float64   `json:"burst" db:"burst"`
+	AccountAge float64   `json:"account_age" db:"account_age"`
+	Deviation  float64   `json:"deviation" db:"deviation"`
+	Held       bool      `json:"held" db:"held"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
@@ -7,7 +7,36 @@ type Customer struct {
 	Name     string       `json:"name" db:"name"`
 	About    string       `json:"about" db:"about"`
 	Type     CustomerType `json:"type" db:"type"`
+	Version  int64        `json:"version" db:"version"`
 
-	CreatedAt time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
-}
\ No newline at end of file
+	Reputation float64           `json:"reputation" db:"-"`
+	Screening  *ScreeningVerdict `json:"screening,omitempty" db:"screening"`
+
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	// HiddenAt is set while the customer is hidden from lookups and listings
+	// after reports.
+	HiddenAt *time.Time `json:"hidden_at,omitempty" db:"hidden_at"`
+}
+type CustomerFilter struct {
+	MaxID       string
+	NameQuery   string
+	Type        CustomerType
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	UpdatedFrom time.Time
+	UpdatedTo   time.Time
+
+	SortBy        CustomerSortField
+	SortDirection SortDirection
+}
+
+// CustomerSearchHit is a full-text search match. NameHighlight and AboutSnippet
+// are HTML-escaped and wrap matched words in <b></b>.
+type CustomerSearchHit struct {
+	Customer
+	Rank          float64 `json:"rank" db:"rank"`
+	NameHighlight string  `json:"name_highlight" db:"name_highlight"`
+	AboutSnippet  string  `json:"about_snippet" db:"about_snippet"`
+}
@@ -3,12 +3,85 @@ package domain
 import "time"
 
 type Feedback struct {
-	ID         string    `json:"id" db:"id"`
-	CustomerID string    `json:"customer_id" db:"customer_id"`
-	UserID     string    `json:"user_id" db:"user_id"`
-	Rating     int       `json:"rating" db:"rating"`
-	Comment    string    `json:"comment" db:"comment"`
-	TaskID     string    `json:"task_id" db:"task_id"`
-	CreatedAt  time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	ID         string         `json:"id" db:"id"`
+	CustomerID string         `json:"customer_id" db:"customer_id"`
+	UserID     string         `json:"user_id" db:"user_id"`
+	Rating     int            `json:"rating" db:"rating"`
+	Comment    string         `json:"comment" db:"comment"`
+	TaskID     string         `json:"task_id" db:"task_id"`
+	Status     FeedbackStatus `json:"status" db:"status"`
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at" db:"updated_at"`
+
+	Screening *ScreeningVerdict `json:"screening,omitempty" db:"screening"`
+	Reply     *FeedbackReply    `json:"reply,omitempty" db:"-"`
+	Scores    []CriterionScore  `json:"scores,omitempty" db:"-"`
+	Abuse     *AbuseAssessment  `json:"abuse,omitempty" db:"-"`
+}
+
+// CriterionScore is the 1-5 score a feedback gives for one rating criterion.
+type CriterionScore struct {
+	Criterion string `json:"criterion" db:"criterion"`
+	Score     int    `json:"score" db:"score"`
+}
+
+type FeedbackStatus string
+
+const (
+	FeedbackStatusPending   FeedbackStatus = "pending"
+	FeedbackStatusPublished FeedbackStatus = "published"
+	FeedbackStatusRejected  FeedbackStatus = "rejected"
+	FeedbackStatusHidden    FeedbackStatus = "hidden"
+)
+
+type FeedbackFilter struct {
+	TaskID      string
+	UserID      string
+	CustomerID  string
+	MinRating   int
+	MaxRating   int
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+}
+
+type FeedbackRevisionAction string
+
+const (
+	FeedbackRevisionActionUpdate FeedbackRevisionAction = "update"
+	FeedbackRevisionActionDelete FeedbackRevisionAction = "delete"
+)
+
+// FeedbackRevision keeps the text of a feedback as it was before an edit or
+// deletion. Revisions are not linked by foreign key so they outlive the
+// feedback itself.
+type FeedbackRevision struct {
+	ID         string                 `json:"id" db:"id"`
+	FeedbackID string                 `json:"feedback_id" db:"feedback_id"`
+	CustomerID string                 `json:"customer_id" db:"customer_id"`
+	UserID     string                 `json:"user_id" db:"user_id"`
+	Action     FeedbackRevisionAction `json:"action" db:"action"`
+	Rating     int                    `json:"rating" db:"rating"`
+	Comment    string                 `json:"comment" db:"comment"`
+	CreatedAt  time.Time              `json:"created_at" db:"created_at"`
+}
+
+type FeedbackModeration struct {
+	ID          string         `json:"id" db:"id"`
+	FeedbackID  string         `json:"feedback_id" db:"feedback_id"`
+	ModeratorID string         `json:"moderator_id" db:"moderator_id"`
+	Decision    FeedbackStatus `json:"decision" db:"decision"`
+	Reason      string         `json:"reason" db:"reason"`
+	CreatedAt   time.Time      `json:"created_at" db:"created_at"`
+}
+
+// FeedbackReply is the public answer of the customer a feedback is about. A
+// feedback has at most one reply.
+type FeedbackReply struct {
+	ID         string            `json:"id" db:"id"`
+	FeedbackID string            `json:"feedback_id" db:"feedback_id"`
+	CustomerID string            `json:"customer_id" db:"customer_id"`
+	Text       string            `json:"text" db:"text"`
+	Screening  *ScreeningVerdict `json:"screening,omitempty" db:"screening"`
+	CreatedAt  time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time         `json:"updated_at" db:"updated_at"`
 }
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+type CustomerRating struct {
+	CustomerID   string      `json:"customer_id"`
+	Count        int         `json:"count"`
+	Sum          int         `json:"sum"`
+	Distribution map[int]int `json:"distribution"`
+	UpdatedAt    time.Time   `json:"updated_at"`
+
+	Criteria []*CriterionRating `json:"criteria,omitempty"`
+}
+
+func (r *CustomerRating) Average() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return float64(r.Sum) / float64(r.Count)
+}
+
+// CriterionRating sums up the scores a customer received for one criterion.
+type CriterionRating struct {
+	Criterion string `json:"criterion" db:"criterion"`
+	Count     int    `json:"count" db:"ratings_count"`
+	Sum       int    `json:"sum" db:"ratings_sum"`
+}
+
+func (r *CriterionRating) Average() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return float64(r.Sum) / float64(r.Count)
+}
+
+// WeightedRating is the sum of a customer's ratings and the total weight they
+// carry. Without time decay every rating weighs 1 and Weight equals the count.
+type WeightedRating struct {
+	CustomerID string  `json:"customer_id" db:"customer_id"`
+	Sum        float64 `json:"sum" db:"weighted_sum"`
+	Weight     float64 `json:"weight" db:"weight"`
+}
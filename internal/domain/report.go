@@ -0,0 +1,70 @@
+package domain
+
+import "time"
+
+type ReportTarget string
+
+const (
+	ReportTargetCustomer ReportTarget = "customer"
+	ReportTargetFeedback ReportTarget = "feedback"
+)
+
+type ReportReason string
+
+const (
+	ReportReasonSpam      ReportReason = "spam"
+	ReportReasonFraud     ReportReason = "fraud"
+	ReportReasonOffensive ReportReason = "offensive"
+	ReportReasonFake      ReportReason = "fake"
+	ReportReasonOther     ReportReason = "other"
+)
+
+func (r ReportReason) Valid() bool {
+	switch r {
+	case ReportReasonSpam, ReportReasonFraud, ReportReasonOffensive, ReportReasonFake, ReportReasonOther:
+		return true
+	}
+	return false
+}
+
+// ReportStatus tells whether an admin has looked at a report yet. Upheld
+// reports keep the target hidden, dismissed ones bring it back.
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusUpheld    ReportStatus = "upheld"
+	ReportStatusDismissed ReportStatus = "dismissed"
+)
+
+// Report flags a customer or a feedback as abusive. A reporter reports the
+// same target at most once.
+type Report struct {
+	ID             string       `json:"id" db:"id"`
+	TargetType     ReportTarget `json:"target_type" db:"target_type"`
+	TargetID       string       `json:"target_id" db:"target_id"`
+	ReporterID     string       `json:"reporter_id" db:"reporter_id"`
+	Reason         ReportReason `json:"reason" db:"reason"`
+	Comment        string       `json:"comment" db:"comment"`
+	Status         ReportStatus `json:"status" db:"status"`
+	ResolvedBy     string       `json:"resolved_by,omitempty" db:"resolved_by"`
+	ResolutionNote string       `json:"resolution_note,omitempty" db:"resolution_note"`
+	CreatedAt      time.Time    `json:"created_at" db:"created_at"`
+	ResolvedAt     *time.Time   `json:"resolved_at,omitempty" db:"resolved_at"`
+}
+
+type ReportFilter struct {
+	TargetType ReportTarget
+	TargetID   string
+	Status     ReportStatus
+	Reason     ReportReason
+}
+
+// ReportResolution closes all open reports of one target.
+type ReportResolution struct {
+	TargetType ReportTarget
+	TargetID   string
+	Decision   ReportStatus
+	ResolverID string
+	Note       string
+}
@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+type ScreeningAction string
+
+const (
+	ScreeningActionAllow    ScreeningAction = "allow"
+	ScreeningActionMask     ScreeningAction = "mask"
+	ScreeningActionModerate ScreeningAction = "moderate"
+	ScreeningActionReject   ScreeningAction = "reject"
+)
+
+// Severity orders actions so that the strictest one wins when several rules
+// match the same text.
+func (a ScreeningAction) Severity() int {
+	switch a {
+	case ScreeningActionMask:
+		return 1
+	case ScreeningActionModerate:
+		return 2
+	case ScreeningActionReject:
+		return 3
+	}
+	return 0
+}
+
+type ScreeningCategory string
+
+const (
+	ScreeningCategoryObscene ScreeningCategory = "obscene"
+	ScreeningCategoryContact ScreeningCategory = "contact"
+	ScreeningCategoryLink    ScreeningCategory = "link"
+	ScreeningCategorySpam    ScreeningCategory = "spam"
+)
+
+// ScreeningVerdict is the outcome of screening a piece of text. It is stored
+// as JSON next to the record it was produced for.
+type ScreeningVerdict struct {
+	Action     ScreeningAction     `json:"action"`
+	Categories []ScreeningCategory `json:"categories,omitempty"`
+}
+
+// Merge combines two verdicts, keeping the strictest action and every
+// category.
+func (v *ScreeningVerdict) Merge(other *ScreeningVerdict) *ScreeningVerdict {
+	if v == nil {
+		return other
+	}
+	if other == nil {
+		return v
+	}
+	merged := &ScreeningVerdict{Action: v.Action}
+	if other.Action.Severity() > merged.Action.Severity() {
+		merged.Action = other.Action
+	}
+	merged.Categories = append(merged.Categories, v.Categories...)
+	for _, category := range other.Categories {
+		if !slices.Contains(merged.Categories, category) {
+			merged.Categories = append(merged.Categories, category)
+		}
+	}
+	return merged
+}
+
+func (v ScreeningVerdict) Value() (driver.Value, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+func (v *ScreeningVerdict) Scan(src any) error {
+	switch data := src.(type) {
+	case []byte:
+		return json.Unmarshal(data, v)
+	case string:
+		return json.Unmarshal([]byte(data), v)
+	}
+	return fmt.Errorf("unsupported screening verdict type %T", src)
+}
@@ -10,3 +10,35 @@ const (
 func (t CustomerType) String() string {
 	return string(t)
 }
+
+type CustomerSortField string
+
+const (
+	CustomerSortByCreatedAt  CustomerSortField = "created_at"
+	CustomerSortByUpdatedAt  CustomerSortField = "updated_at"
+	CustomerSortByName       CustomerSortField = "name"
+	CustomerSortByReputation CustomerSortField = "reputation"
+)
+
+type SortDirection string
+
+const (
+	SortAsc  SortDirection = "asc"
+	SortDesc SortDirection = "desc"
+)
+
+const (
+	CustomerFieldName  = "name"
+	CustomerFieldAbout = "about"
+	CustomerFieldType  = "type"
+)
+
+var CustomerUpdatableFields = []string{CustomerFieldName, CustomerFieldAbout, CustomerFieldType}
+
+// Page selects a slice of a list either by an opaque keyset Token or, when no
+// token is given, by Offset.
+type Page struct {
+	Limit  int
+	Offset int
+	Token  string
+}
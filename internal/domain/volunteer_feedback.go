@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// VolunteerFeedback is a review a customer leaves about the volunteer who did
+// a task for them, the reverse of Feedback.
+type VolunteerFeedback struct {
+	ID         string    `json:"id" db:"id"`
+	CustomerID string    `json:"customer_id" db:"customer_id"`
+	UserID     string    `json:"user_id" db:"user_id"`
+	TaskID     string    `json:"task_id" db:"task_id"`
+	Rating     int       `json:"rating" db:"rating"`
+	Comment    string    `json:"comment" db:"comment"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+
+	Screening *ScreeningVerdict `json:"screening,omitempty" db:"screening"`
+}
+
+type VolunteerFeedbackFilter struct {
+	UserID     string
+	CustomerID string
+	TaskID     string
+}
+
+type VolunteerRating struct {
+	UserID       string      `json:"user_id"`
+	Count        int         `json:"count"`
+	Sum          int         `json:"sum"`
+	Distribution map[int]int `json:"distribution"`
+}
+
+func (r *VolunteerRating) Average() float64 {
+	if r.Count == 0 {
+		return 0
+	}
+	return float64(r.Sum) / float64(r.Count)
+}
@@ -7,8 +7,10 @@
 package customer
 
 import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,6 +23,171 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type FeedbackStatus int32
+
+const (
+	FeedbackStatus_FEEDBACK_STATUS_UNSPECIFIED FeedbackStatus = 0
+	FeedbackStatus_FEEDBACK_STATUS_PENDING     FeedbackStatus = 1
+	FeedbackStatus_FEEDBACK_STATUS_PUBLISHED   FeedbackStatus = 2
+	FeedbackStatus_FEEDBACK_STATUS_REJECTED    FeedbackStatus = 3
+	FeedbackStatus_FEEDBACK_STATUS_HIDDEN      FeedbackStatus = 4
+)
+
+// Enum value maps for FeedbackStatus.
+var (
+	FeedbackStatus_name = map[int32]string{
+		0: "FEEDBACK_STATUS_UNSPECIFIED",
+		1: "FEEDBACK_STATUS_PENDING",
+		2: "FEEDBACK_STATUS_PUBLISHED",
+		3: "FEEDBACK_STATUS_REJECTED",
+		4: "FEEDBACK_STATUS_HIDDEN",
+	}
+	FeedbackStatus_value = map[string]int32{
+		"FEEDBACK_STATUS_UNSPECIFIED": 0,
+		"FEEDBACK_STATUS_PENDING":     1,
+		"FEEDBACK_STATUS_PUBLISHED":   2,
+		"FEEDBACK_STATUS_REJECTED":    3,
+		"FEEDBACK_STATUS_HIDDEN":      4,
+	}
+)
+
+func (x FeedbackStatus) Enum() *FeedbackStatus {
+	p := new(FeedbackStatus)
+	*p = x
+	return p
+}
+
+func (x FeedbackStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeedbackStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[0].Descriptor()
+}
+
+func (FeedbackStatus) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[0]
+}
+
+func (x FeedbackStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeedbackStatus.Descriptor instead.
+func (FeedbackStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{0}
+}
+
+type ScreeningAction int32
+
+const (
+	ScreeningAction_SCREENING_ACTION_UNSPECIFIED ScreeningAction = 0
+	ScreeningAction_SCREENING_ACTION_ALLOW       ScreeningAction = 1
+	ScreeningAction_SCREENING_ACTION_MASK        ScreeningAction = 2
+	ScreeningAction_SCREENING_ACTION_MODERATE    ScreeningAction = 3
+	ScreeningAction_SCREENING_ACTION_REJECT      ScreeningAction = 4
+)
+
+// Enum value maps for ScreeningAction.
+var (
+	ScreeningAction_name = map[int32]string{
+		0: "SCREENING_ACTION_UNSPECIFIED",
+		1: "SCREENING_ACTION_ALLOW",
+		2: "SCREENING_ACTION_MASK",
+		3: "SCREENING_ACTION_MODERATE",
+		4: "SCREENING_ACTION_REJECT",
+	}
+	ScreeningAction_value = map[string]int32{
+		"SCREENING_ACTION_UNSPECIFIED": 0,
+		"SCREENING_ACTION_ALLOW":       1,
+		"SCREENING_ACTION_MASK":        2,
+		"SCREENING_ACTION_MODERATE":    3,
+		"SCREENING_ACTION_REJECT":      4,
+	}
+)
+
+func (x ScreeningAction) Enum() *ScreeningAction {
+	p := new(ScreeningAction)
+	*p = x
+	return p
+}
+
+func (x ScreeningAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreeningAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[1].Descriptor()
+}
+
+func (ScreeningAction) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[1]
+}
+
+func (x ScreeningAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreeningAction.Descriptor instead.
+func (ScreeningAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{1}
+}
+
+type ScreeningCategory int32
+
+const (
+	ScreeningCategory_SCREENING_CATEGORY_UNSPECIFIED ScreeningCategory = 0
+	ScreeningCategory_SCREENING_CATEGORY_OBSCENE     ScreeningCategory = 1
+	ScreeningCategory_SCREENING_CATEGORY_CONTACT     ScreeningCategory = 2
+	ScreeningCategory_SCREENING_CATEGORY_LINK        ScreeningCategory = 3
+	ScreeningCategory_SCREENING_CATEGORY_SPAM        ScreeningCategory = 4
+)
+
+// Enum value maps for ScreeningCategory.
+var (
+	ScreeningCategory_name = map[int32]string{
+		0: "SCREENING_CATEGORY_UNSPECIFIED",
+		1: "SCREENING_CATEGORY_OBSCENE",
+		2: "SCREENING_CATEGORY_CONTACT",
+		3: "SCREENING_CATEGORY_LINK",
+		4: "SCREENING_CATEGORY_SPAM",
+	}
+	ScreeningCategory_value = map[string]int32{
+		"SCREENING_CATEGORY_UNSPECIFIED": 0,
+		"SCREENING_CATEGORY_OBSCENE":     1,
+		"SCREENING_CATEGORY_CONTACT":     2,
+		"SCREENING_CATEGORY_LINK":        3,
+		"SCREENING_CATEGORY_SPAM":        4,
+	}
+)
+
+func (x ScreeningCategory) Enum() *ScreeningCategory {
+	p := new(ScreeningCategory)
+	*p = x
+	return p
+}
+
+func (x ScreeningCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScreeningCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[2].Descriptor()
+}
+
+func (ScreeningCategory) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[2]
+}
+
+func (x ScreeningCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScreeningCategory.Descriptor instead.
+func (ScreeningCategory) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{2}
+}
+
 type CustomerType int32
 
 const (
@@ -54,11 +221,11 @@ func (x CustomerType) String() string {
 }
 
 func (CustomerType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_customer_customer_proto_enumTypes[0].Descriptor()
+	return file_proto_customer_customer_proto_enumTypes[3].Descriptor()
 }
 
 func (CustomerType) Type() protoreflect.EnumType {
-	return &file_proto_customer_customer_proto_enumTypes[0]
+	return &file_proto_customer_customer_proto_enumTypes[3]
 }
 
 func (x CustomerType) Number() protoreflect.EnumNumber {
@@ -67,7 +234,270 @@ func (x CustomerType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CustomerType.Descriptor instead.
 func (CustomerType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{0}
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{3}
+}
+
+type CustomerSortField int32
+
+const (
+	CustomerSortField_CUSTOMER_SORT_FIELD_UNSPECIFIED CustomerSortField = 0
+	CustomerSortField_CUSTOMER_SORT_FIELD_CREATED_AT  CustomerSortField = 1
+	CustomerSortField_CUSTOMER_SORT_FIELD_REPUTATION  CustomerSortField = 2
+	CustomerSortField_CUSTOMER_SORT_FIELD_UPDATED_AT  CustomerSortField = 3
+	CustomerSortField_CUSTOMER_SORT_FIELD_NAME        CustomerSortField = 4
+)
+
+// Enum value maps for CustomerSortField.
+var (
+	CustomerSortField_name = map[int32]string{
+		0: "CUSTOMER_SORT_FIELD_UNSPECIFIED",
+		1: "CUSTOMER_SORT_FIELD_CREATED_AT",
+		2: "CUSTOMER_SORT_FIELD_REPUTATION",
+		3: "CUSTOMER_SORT_FIELD_UPDATED_AT",
+		4: "CUSTOMER_SORT_FIELD_NAME",
+	}
+	CustomerSortField_value = map[string]int32{
+		"CUSTOMER_SORT_FIELD_UNSPECIFIED": 0,
+		"CUSTOMER_SORT_FIELD_CREATED_AT":  1,
+		"CUSTOMER_SORT_FIELD_REPUTATION":  2,
+		"CUSTOMER_SORT_FIELD_UPDATED_AT":  3,
+		"CUSTOMER_SORT_FIELD_NAME":        4,
+	}
+)
+
+func (x CustomerSortField) Enum() *CustomerSortField {
+	p := new(CustomerSortField)
+	*p = x
+	return p
+}
+
+func (x CustomerSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CustomerSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[4].Descriptor()
+}
+
+func (CustomerSortField) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[4]
+}
+
+func (x CustomerSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CustomerSortField.Descriptor instead.
+func (CustomerSortField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{4}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_UNSPECIFIED SortDirection = 0
+	SortDirection_SORT_DIRECTION_ASC         SortDirection = 1
+	SortDirection_SORT_DIRECTION_DESC        SortDirection = 2
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_UNSPECIFIED",
+		1: "SORT_DIRECTION_ASC",
+		2: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_UNSPECIFIED": 0,
+		"SORT_DIRECTION_ASC":         1,
+		"SORT_DIRECTION_DESC":        2,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[5].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[5]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{5}
+}
+
+type ReportTarget int32
+
+const (
+	ReportTarget_REPORT_TARGET_UNSPECIFIED ReportTarget = 0
+	ReportTarget_REPORT_TARGET_CUSTOMER    ReportTarget = 1
+	ReportTarget_REPORT_TARGET_FEEDBACK    ReportTarget = 2
+)
+
+// Enum value maps for ReportTarget.
+var (
+	ReportTarget_name = map[int32]string{
+		0: "REPORT_TARGET_UNSPECIFIED",
+		1: "REPORT_TARGET_CUSTOMER",
+		2: "REPORT_TARGET_FEEDBACK",
+	}
+	ReportTarget_value = map[string]int32{
+		"REPORT_TARGET_UNSPECIFIED": 0,
+		"REPORT_TARGET_CUSTOMER":    1,
+		"REPORT_TARGET_FEEDBACK":    2,
+	}
+)
+
+func (x ReportTarget) Enum() *ReportTarget {
+	p := new(ReportTarget)
+	*p = x
+	return p
+}
+
+func (x ReportTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[6].Descriptor()
+}
+
+func (ReportTarget) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[6]
+}
+
+func (x ReportTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportTarget.Descriptor instead.
+func (ReportTarget) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{6}
+}
+
+type ReportReason int32
+
+const (
+	ReportReason_REPORT_REASON_UNSPECIFIED ReportReason = 0
+	ReportReason_REPORT_REASON_SPAM        ReportReason = 1
+	ReportReason_REPORT_REASON_FRAUD       ReportReason = 2
+	ReportReason_REPORT_REASON_OFFENSIVE   ReportReason = 3
+	ReportReason_REPORT_REASON_FAKE        ReportReason = 4
+	ReportReason_REPORT_REASON_OTHER       ReportReason = 5
+)
+
+// Enum value maps for ReportReason.
+var (
+	ReportReason_name = map[int32]string{
+		0: "REPORT_REASON_UNSPECIFIED",
+		1: "REPORT_REASON_SPAM",
+		2: "REPORT_REASON_FRAUD",
+		3: "REPORT_REASON_OFFENSIVE",
+		4: "REPORT_REASON_FAKE",
+		5: "REPORT_REASON_OTHER",
+	}
+	ReportReason_value = map[string]int32{
+		"REPORT_REASON_UNSPECIFIED": 0,
+		"REPORT_REASON_SPAM":        1,
+		"REPORT_REASON_FRAUD":       2,
+		"REPORT_REASON_OFFENSIVE":   3,
+		"REPORT_REASON_FAKE":        4,
+		"REPORT_REASON_OTHER":       5,
+	}
+)
+
+func (x ReportReason) Enum() *ReportReason {
+	p := new(ReportReason)
+	*p = x
+	return p
+}
+
+func (x ReportReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[7].Descriptor()
+}
+
+func (ReportReason) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[7]
+}
+
+func (x ReportReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportReason.Descriptor instead.
+func (ReportReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{7}
+}
+
+type ReportStatus int32
+
+const (
+	ReportStatus_REPORT_STATUS_UNSPECIFIED ReportStatus = 0
+	ReportStatus_REPORT_STATUS_OPEN        ReportStatus = 1
+	ReportStatus_REPORT_STATUS_UPHELD      ReportStatus = 2
+	ReportStatus_REPORT_STATUS_DISMISSED   ReportStatus = 3
+)
+
+// Enum value maps for ReportStatus.
+var (
+	ReportStatus_name = map[int32]string{
+		0: "REPORT_STATUS_UNSPECIFIED",
+		1: "REPORT_STATUS_OPEN",
+		2: "REPORT_STATUS_UPHELD",
+		3: "REPORT_STATUS_DISMISSED",
+	}
+	ReportStatus_value = map[string]int32{
+		"REPORT_STATUS_UNSPECIFIED": 0,
+		"REPORT_STATUS_OPEN":        1,
+		"REPORT_STATUS_UPHELD":      2,
+		"REPORT_STATUS_DISMISSED":   3,
+	}
+)
+
+func (x ReportStatus) Enum() *ReportStatus {
+	p := new(ReportStatus)
+	*p = x
+	return p
+}
+
+func (x ReportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_customer_customer_proto_enumTypes[8].Descriptor()
+}
+
+func (ReportStatus) Type() protoreflect.EnumType {
+	return &file_proto_customer_customer_proto_enumTypes[8]
+}
+
+func (x ReportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReportStatus.Descriptor instead.
+func (ReportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{8}
 }
 
 type ErrorCode int32
@@ -79,6 +509,10 @@ const (
 	ErrorCode_ERROR_CODE_INTERNAL       ErrorCode = 3
 	ErrorCode_ERROR_CODE_ALREADY_EXISTS ErrorCode = 4
 	ErrorCode_ERROR_CODE_NOT_ENOUGH     ErrorCode = 5
+	ErrorCode_ERROR_CODE_CONFLICT       ErrorCode = 6
+	ErrorCode_ERROR_CODE_FORBIDDEN      ErrorCode = 7
+	ErrorCode_ERROR_CODE_UNAVAILABLE    ErrorCode = 8
+	ErrorCode_ERROR_CODE_RATE_LIMITED   ErrorCode = 9
 )
 
 // Enum value maps for ErrorCode.
@@ -90,6 +524,10 @@ var (
 		3: "ERROR_CODE_INTERNAL",
 		4: "ERROR_CODE_ALREADY_EXISTS",
 		5: "ERROR_CODE_NOT_ENOUGH",
+		6: "ERROR_CODE_CONFLICT",
+		7: "ERROR_CODE_FORBIDDEN",
+		8: "ERROR_CODE_UNAVAILABLE",
+		9: "ERROR_CODE_RATE_LIMITED",
 	}
 	ErrorCode_value = map[string]int32{
 		"ERROR_CODE_UNSPECIFIED":    0,
@@ -98,6 +536,10 @@ var (
 		"ERROR_CODE_INTERNAL":       3,
 		"ERROR_CODE_ALREADY_EXISTS": 4,
 		"ERROR_CODE_NOT_ENOUGH":     5,
+		"ERROR_CODE_CONFLICT":       6,
+		"ERROR_CODE_FORBIDDEN":      7,
+		"ERROR_CODE_UNAVAILABLE":    8,
+		"ERROR_CODE_RATE_LIMITED":   9,
 	}
 )
 
@@ -112,11 +554,11 @@ func (x ErrorCode) String() string {
 }
 
 func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_customer_customer_proto_enumTypes[1].Descriptor()
+	return file_proto_customer_customer_proto_enumTypes[9].Descriptor()
 }
 
 func (ErrorCode) Type() protoreflect.EnumType {
-	return &file_proto_customer_customer_proto_enumTypes[1]
+	return &file_proto_customer_customer_proto_enumTypes[9]
 }
 
 func (x ErrorCode) Number() protoreflect.EnumNumber {
@@ -125,7 +567,7 @@ func (x ErrorCode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ErrorCode.Descriptor instead.
 func (ErrorCode) EnumDescriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{1}
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{9}
 }
 
 type GetFeedbackByIDRequest struct {
@@ -225,8 +667,11 @@ func (x *GetFeedbackByIDResponse) GetError() *Error {
 }
 
 type CreateFeedbackRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Feedback      *Feedback              `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Feedback *Feedback              `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
+	// When the user has already reviewed the task, edit that feedback instead
+	// of failing with ERROR_CODE_ALREADY_EXISTS. The edit window still applies.
+	Upsert        bool `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -268,6 +713,13 @@ func (x *CreateFeedbackRequest) GetFeedback() *Feedback {
 	return nil
 }
 
+func (x *CreateFeedbackRequest) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
 type CreateFeedbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Feedback      *Feedback              `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
@@ -320,30 +772,36 @@ func (x *CreateFeedbackResponse) GetError() *Error {
 	return nil
 }
 
-type GetFeedbacksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+type UpdateFeedbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Author of the feedback; nobody else may change it.
+	UserId  string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating  int32  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	// Criterion scores replacing the stored ones. They are applied only with
+	// replace_scores, so that an empty list clears the scores while leaving
+	// both out keeps them.
+	Scores        []*CriterionScore `protobuf:"bytes,5,rep,name=scores,proto3" json:"scores,omitempty"`
+	ReplaceScores bool              `protobuf:"varint,6,opt,name=replace_scores,json=replaceScores,proto3" json:"replace_scores,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFeedbacksRequest) Reset() {
-	*x = GetFeedbacksRequest{}
+func (x *UpdateFeedbackRequest) Reset() {
+	*x = UpdateFeedbackRequest{}
 	mi := &file_proto_customer_customer_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedbacksRequest) String() string {
+func (x *UpdateFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedbacksRequest) ProtoMessage() {}
+func (*UpdateFeedbackRequest) ProtoMessage() {}
 
-func (x *GetFeedbacksRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateFeedbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,62 +813,75 @@ func (x *GetFeedbacksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedbacksRequest.ProtoReflect.Descriptor instead.
-func (*GetFeedbacksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFeedbackRequest) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetFeedbacksRequest) GetTaskId() string {
+func (x *UpdateFeedbackRequest) GetId() string {
 	if x != nil {
-		return x.TaskId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetFeedbacksRequest) GetUserId() string {
+func (x *UpdateFeedbackRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *GetFeedbacksRequest) GetLimit() int32 {
+func (x *UpdateFeedbackRequest) GetRating() int32 {
 	if x != nil {
-		return x.Limit
+		return x.Rating
 	}
 	return 0
 }
 
-func (x *GetFeedbacksRequest) GetOffset() int32 {
+func (x *UpdateFeedbackRequest) GetComment() string {
 	if x != nil {
-		return x.Offset
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
-type GetFeedbacksResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Feedbacks     []*Feedback            `protobuf:"bytes,1,rep,name=Feedbacks,proto3" json:"Feedbacks,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *UpdateFeedbackRequest) GetScores() []*CriterionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
 }
 
-func (x *GetFeedbacksResponse) Reset() {
-	*x = GetFeedbacksResponse{}
+func (x *UpdateFeedbackRequest) GetReplaceScores() bool {
+	if x != nil {
+		return x.ReplaceScores
+	}
+	return false
+}
+
+type UpdateFeedbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedback      *Feedback              `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFeedbackResponse) Reset() {
+	*x = UpdateFeedbackResponse{}
 	mi := &file_proto_customer_customer_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFeedbacksResponse) String() string {
+func (x *UpdateFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFeedbacksResponse) ProtoMessage() {}
+func (*UpdateFeedbackResponse) ProtoMessage() {}
 
-func (x *GetFeedbacksResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateFeedbackResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -422,54 +893,48 @@ func (x *GetFeedbacksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFeedbacksResponse.ProtoReflect.Descriptor instead.
-func (*GetFeedbacksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFeedbackResponse) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *GetFeedbacksResponse) GetFeedbacks() []*Feedback {
+func (x *UpdateFeedbackResponse) GetFeedback() *Feedback {
 	if x != nil {
-		return x.Feedbacks
+		return x.Feedback
 	}
 	return nil
 }
 
-func (x *GetFeedbacksResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetFeedbacksResponse) GetError() *Error {
+func (x *UpdateFeedbackResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type CountFeedbacksRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+type DeleteFeedbackRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Author of the feedback; nobody else may delete it.
+	UserId        string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CountFeedbacksRequest) Reset() {
-	*x = CountFeedbacksRequest{}
+func (x *DeleteFeedbackRequest) Reset() {
+	*x = DeleteFeedbackRequest{}
 	mi := &file_proto_customer_customer_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CountFeedbacksRequest) String() string {
+func (x *DeleteFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountFeedbacksRequest) ProtoMessage() {}
+func (*DeleteFeedbackRequest) ProtoMessage() {}
 
-func (x *CountFeedbacksRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteFeedbackRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -481,47 +946,47 @@ func (x *CountFeedbacksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountFeedbacksRequest.ProtoReflect.Descriptor instead.
-func (*CountFeedbacksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeedbackRequest) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CountFeedbacksRequest) GetTaskId() string {
+func (x *DeleteFeedbackRequest) GetId() string {
 	if x != nil {
-		return x.TaskId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CountFeedbacksRequest) GetUserId() string {
+func (x *DeleteFeedbackRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type CountFeedbacksResponse struct {
+type DeleteFeedbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CountFeedbacksResponse) Reset() {
-	*x = CountFeedbacksResponse{}
+func (x *DeleteFeedbackResponse) Reset() {
+	*x = DeleteFeedbackResponse{}
 	mi := &file_proto_customer_customer_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CountFeedbacksResponse) String() string {
+func (x *DeleteFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountFeedbacksResponse) ProtoMessage() {}
+func (*DeleteFeedbackResponse) ProtoMessage() {}
 
-func (x *CountFeedbacksResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteFeedbackResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -533,53 +998,52 @@ func (x *CountFeedbacksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountFeedbacksResponse.ProtoReflect.Descriptor instead.
-func (*CountFeedbacksResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeedbackResponse) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CountFeedbacksResponse) GetTotal() int32 {
+func (x *DeleteFeedbackResponse) GetId() string {
 	if x != nil {
-		return x.Total
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *CountFeedbacksResponse) GetError() *Error {
+func (x *DeleteFeedbackResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type Feedback struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
-	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
-	TaskId        string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CustomerId    string                 `protobuf:"bytes,6,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
-	CreatedAt     int32                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int32                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type GetFeedbacksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Limit  int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Ignored when page_token is set.
+	Offset int32 `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	// next_page_token from a previous response.
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Feedback) Reset() {
-	*x = Feedback{}
+func (x *GetFeedbacksRequest) Reset() {
+	*x = GetFeedbacksRequest{}
 	mi := &file_proto_customer_customer_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Feedback) String() string {
+func (x *GetFeedbacksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Feedback) ProtoMessage() {}
+func (*GetFeedbacksRequest) ProtoMessage() {}
 
-func (x *Feedback) ProtoReflect() protoreflect.Message {
+func (x *GetFeedbacksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -591,93 +1055,70 @@ func (x *Feedback) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Feedback.ProtoReflect.Descriptor instead.
-func (*Feedback) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFeedbacksRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedbacksRequest) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Feedback) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Feedback) GetRating() int32 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
-func (x *Feedback) GetComment() string {
-	if x != nil {
-		return x.Comment
-	}
-	return ""
-}
-
-func (x *Feedback) GetTaskId() string {
+func (x *GetFeedbacksRequest) GetTaskId() string {
 	if x != nil {
 		return x.TaskId
 	}
 	return ""
 }
 
-func (x *Feedback) GetUserId() string {
+func (x *GetFeedbacksRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *Feedback) GetCustomerId() string {
+func (x *GetFeedbacksRequest) GetLimit() int32 {
 	if x != nil {
-		return x.CustomerId
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *Feedback) GetCreatedAt() int32 {
+func (x *GetFeedbacksRequest) GetOffset() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *Feedback) GetUpdatedAt() int32 {
+func (x *GetFeedbacksRequest) GetPageToken() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.PageToken
 	}
-	return 0
+	return ""
 }
 
-type Customer struct {
+type GetFeedbacksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	About         string                 `protobuf:"bytes,3,opt,name=about,proto3" json:"about,omitempty"`
-	Type          CustomerType           `protobuf:"varint,4,opt,name=type,proto3,enum=customer.CustomerType" json:"type,omitempty"`
-	CreatedAt     int32                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int32                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Feedbacks     []*Feedback            `protobuf:"bytes,1,rep,name=Feedbacks,proto3" json:"Feedbacks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Customer) Reset() {
-	*x = Customer{}
+func (x *GetFeedbacksResponse) Reset() {
+	*x = GetFeedbacksResponse{}
 	mi := &file_proto_customer_customer_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Customer) String() string {
+func (x *GetFeedbacksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Customer) ProtoMessage() {}
+func (*GetFeedbacksResponse) ProtoMessage() {}
 
-func (x *Customer) ProtoReflect() protoreflect.Message {
+func (x *GetFeedbacksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -689,74 +1130,66 @@ func (x *Customer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
-func (*Customer) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetFeedbacksResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedbacksResponse) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Customer) GetMaxId() string {
-	if x != nil {
-		return x.MaxId
-	}
-	return ""
-}
-
-func (x *Customer) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *Customer) GetAbout() string {
+func (x *GetFeedbacksResponse) GetFeedbacks() []*Feedback {
 	if x != nil {
-		return x.About
+		return x.Feedbacks
 	}
-	return ""
+	return nil
 }
 
-func (x *Customer) GetType() CustomerType {
+func (x *GetFeedbacksResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Type
+		return x.Total
 	}
-	return CustomerType_CUSTOMER_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *Customer) GetCreatedAt() int32 {
+func (x *GetFeedbacksResponse) GetError() *Error {
 	if x != nil {
-		return x.CreatedAt
+		return x.Error
 	}
-	return 0
+	return nil
 }
 
-func (x *Customer) GetUpdatedAt() int32 {
+func (x *GetFeedbacksResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.NextPageToken
 	}
-	return 0
+	return ""
 }
 
-type CreateCustomerRequest struct {
+type CountFeedbacksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	MinRating     int32                  `protobuf:"varint,4,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`
+	MaxRating     int32                  `protobuf:"varint,5,opt,name=max_rating,json=maxRating,proto3" json:"max_rating,omitempty"`
+	CreatedFrom   int32                  `protobuf:"varint,6,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     int32                  `protobuf:"varint,7,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomerRequest) Reset() {
-	*x = CreateCustomerRequest{}
+func (x *CountFeedbacksRequest) Reset() {
+	*x = CountFeedbacksRequest{}
 	mi := &file_proto_customer_customer_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomerRequest) String() string {
+func (x *CountFeedbacksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomerRequest) ProtoMessage() {}
+func (*CountFeedbacksRequest) ProtoMessage() {}
 
-func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
+func (x *CountFeedbacksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -768,41 +1201,82 @@ func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
-func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountFeedbacksRequest.ProtoReflect.Descriptor instead.
+func (*CountFeedbacksRequest) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *CreateCustomerRequest) GetCustomer() *Customer {
+func (x *CountFeedbacksRequest) GetTaskId() string {
 	if x != nil {
-		return x.Customer
+		return x.TaskId
 	}
-	return nil
+	return ""
 }
 
-type GetCustomersRequest struct {
+func (x *CountFeedbacksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CountFeedbacksRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CountFeedbacksRequest) GetMinRating() int32 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *CountFeedbacksRequest) GetMaxRating() int32 {
+	if x != nil {
+		return x.MaxRating
+	}
+	return 0
+}
+
+func (x *CountFeedbacksRequest) GetCreatedFrom() int32 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *CountFeedbacksRequest) GetCreatedTo() int32 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+type CountFeedbacksResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomersRequest) Reset() {
-	*x = GetCustomersRequest{}
+func (x *CountFeedbacksResponse) Reset() {
+	*x = CountFeedbacksResponse{}
 	mi := &file_proto_customer_customer_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomersRequest) String() string {
+func (x *CountFeedbacksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomersRequest) ProtoMessage() {}
+func (*CountFeedbacksResponse) ProtoMessage() {}
 
-func (x *GetCustomersRequest) ProtoReflect() protoreflect.Message {
+func (x *CountFeedbacksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_customer_customer_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,56 +1288,3009 @@ func (x *GetCustomersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomersRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CountFeedbacksResponse.ProtoReflect.Descriptor instead.
+func (*CountFeedbacksResponse) Descriptor() ([]byte, []int) {
 	return file_proto_customer_customer_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *GetCustomersRequest) GetMaxId() string {
+func (x *CountFeedbacksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CountFeedbacksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Feedback struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Rating     int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment    string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	TaskId     string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,6,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	CreatedAt  int32                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  int32                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status     FeedbackStatus         `protobuf:"varint,9,opt,name=status,proto3,enum=customer.FeedbackStatus" json:"status,omitempty"`
+	// Result of automatic content screening; unset when screening is disabled.
+	Screening *ScreeningVerdict `protobuf:"bytes,10,opt,name=screening,proto3" json:"screening,omitempty"`
+	// Answer of the customer, if any.
+	Reply *FeedbackReply `protobuf:"bytes,11,opt,name=reply,proto3" json:"reply,omitempty"`
+	// Optional 1-5 scores for the configured rating criteria.
+	Scores []*CriterionScore `protobuf:"bytes,12,rep,name=scores,proto3" json:"scores,omitempty"`
+	// Only set by ListFeedbacksForModeration.
+	Abuse         *AbuseAssessment `protobuf:"bytes,13,opt,name=abuse,proto3" json:"abuse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feedback) Reset() {
+	*x = Feedback{}
+	mi := &file_proto_customer_customer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feedback) ProtoMessage() {}
+
+func (x *Feedback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feedback.ProtoReflect.Descriptor instead.
+func (*Feedback) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Feedback) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Feedback) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Feedback) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Feedback) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Feedback) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Feedback) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *Feedback) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Feedback) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Feedback) GetStatus() FeedbackStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FeedbackStatus_FEEDBACK_STATUS_UNSPECIFIED
+}
+
+func (x *Feedback) GetScreening() *ScreeningVerdict {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+func (x *Feedback) GetReply() *FeedbackReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *Feedback) GetScores() []*CriterionScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *Feedback) GetAbuse() *AbuseAssessment {
+	if x != nil {
+		return x.Abuse
+	}
+	return nil
+}
+
+// Abuse score of a feedback, 0 to 1, and the components it is made of.
+type AbuseAssessment struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Score      float64                `protobuf:"fixed64,1,opt,name=score,proto3" json:"score,omitempty"`
+	Velocity   float64                `protobuf:"fixed64,2,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	Burst      float64                `protobuf:"fixed64,3,opt,name=burst,proto3" json:"burst,omitempty"`
+	AccountAge float64                `protobuf:"fixed64,4,opt,name=account_age,json=accountAge,proto3" json:"account_age,omitempty"`
+	Deviation  float64                `protobuf:"fixed64,5,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// Held feedback waits for a moderator before it counts towards the rating.
+	Held          bool `protobuf:"varint,6,opt,name=held,proto3" json:"held,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbuseAssessment) Reset() {
+	*x = AbuseAssessment{}
+	mi := &file_proto_customer_customer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbuseAssessment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbuseAssessment) ProtoMessage() {}
+
+func (x *AbuseAssessment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbuseAssessment.ProtoReflect.Descriptor instead.
+func (*AbuseAssessment) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AbuseAssessment) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *AbuseAssessment) GetVelocity() float64 {
+	if x != nil {
+		return x.Velocity
+	}
+	return 0
+}
+
+func (x *AbuseAssessment) GetBurst() float64 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *AbuseAssessment) GetAccountAge() float64 {
+	if x != nil {
+		return x.AccountAge
+	}
+	return 0
+}
+
+func (x *AbuseAssessment) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *AbuseAssessment) GetHeld() bool {
+	if x != nil {
+		return x.Held
+	}
+	return false
+}
+
+type CriterionScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Criterion     string                 `protobuf:"bytes,1,opt,name=criterion,proto3" json:"criterion,omitempty"`
+	Score         int32                  `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriterionScore) Reset() {
+	*x = CriterionScore{}
+	mi := &file_proto_customer_customer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriterionScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionScore) ProtoMessage() {}
+
+func (x *CriterionScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionScore.ProtoReflect.Descriptor instead.
+func (*CriterionScore) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CriterionScore) GetCriterion() string {
+	if x != nil {
+		return x.Criterion
+	}
+	return ""
+}
+
+func (x *CriterionScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type FeedbackReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FeedbackId    string                 `protobuf:"bytes,2,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Text          string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	CreatedAt     int32                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int32                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Screening     *ScreeningVerdict      `protobuf:"bytes,7,opt,name=screening,proto3" json:"screening,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeedbackReply) Reset() {
+	*x = FeedbackReply{}
+	mi := &file_proto_customer_customer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeedbackReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedbackReply) ProtoMessage() {}
+
+func (x *FeedbackReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedbackReply.ProtoReflect.Descriptor instead.
+func (*FeedbackReply) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FeedbackReply) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FeedbackReply) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *FeedbackReply) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *FeedbackReply) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *FeedbackReply) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FeedbackReply) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *FeedbackReply) GetScreening() *ScreeningVerdict {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+type ReplyToFeedbackRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackId string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	// Customer the feedback is about; nobody else may reply.
+	CustomerId    string `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Text          string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToFeedbackRequest) Reset() {
+	*x = ReplyToFeedbackRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToFeedbackRequest) ProtoMessage() {}
+
+func (x *ReplyToFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*ReplyToFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReplyToFeedbackRequest) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *ReplyToFeedbackRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ReplyToFeedbackRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type ReplyToFeedbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reply         *FeedbackReply         `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyToFeedbackResponse) Reset() {
+	*x = ReplyToFeedbackResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyToFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyToFeedbackResponse) ProtoMessage() {}
+
+func (x *ReplyToFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyToFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*ReplyToFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReplyToFeedbackResponse) GetReply() *FeedbackReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *ReplyToFeedbackResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateFeedbackReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackId    string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFeedbackReplyRequest) Reset() {
+	*x = UpdateFeedbackReplyRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFeedbackReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFeedbackReplyRequest) ProtoMessage() {}
+
+func (x *UpdateFeedbackReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFeedbackReplyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFeedbackReplyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateFeedbackReplyRequest) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *UpdateFeedbackReplyRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *UpdateFeedbackReplyRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type UpdateFeedbackReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reply         *FeedbackReply         `protobuf:"bytes,1,opt,name=reply,proto3" json:"reply,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateFeedbackReplyResponse) Reset() {
+	*x = UpdateFeedbackReplyResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateFeedbackReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFeedbackReplyResponse) ProtoMessage() {}
+
+func (x *UpdateFeedbackReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFeedbackReplyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFeedbackReplyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateFeedbackReplyResponse) GetReply() *FeedbackReply {
+	if x != nil {
+		return x.Reply
+	}
+	return nil
+}
+
+func (x *UpdateFeedbackReplyResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DeleteFeedbackReplyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackId    string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeedbackReplyRequest) Reset() {
+	*x = DeleteFeedbackReplyRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeedbackReplyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeedbackReplyRequest) ProtoMessage() {}
+
+func (x *DeleteFeedbackReplyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeedbackReplyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeedbackReplyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteFeedbackReplyRequest) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *DeleteFeedbackReplyRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type DeleteFeedbackReplyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FeedbackId    string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeedbackReplyResponse) Reset() {
+	*x = DeleteFeedbackReplyResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeedbackReplyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeedbackReplyResponse) ProtoMessage() {}
+
+func (x *DeleteFeedbackReplyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFeedbackReplyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFeedbackReplyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteFeedbackReplyResponse) GetFeedbackId() string {
+	if x != nil {
+		return x.FeedbackId
+	}
+	return ""
+}
+
+func (x *DeleteFeedbackReplyResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type ListFeedbacksForModerationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to FEEDBACK_STATUS_PENDING.
+	Status        FeedbackStatus `protobuf:"varint,1,opt,name=status,proto3,enum=customer.FeedbackStatus" json:"status,omitempty"`
+	CustomerId    string         `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Limit         int32          `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32          `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken     string         `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedbacksForModerationRequest) Reset() {
+	*x = ListFeedbacksForModerationRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedbacksForModerationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedbacksForModerationRequest) ProtoMessage() {}
+
+func (x *ListFeedbacksForModerationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedbacksForModerationRequest.ProtoReflect.Descriptor instead.
+func (*ListFeedbacksForModerationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFeedbacksForModerationRequest) GetStatus() FeedbackStatus {
+	if x != nil {
+		return x.Status
+	}
+	return FeedbackStatus_FEEDBACK_STATUS_UNSPECIFIED
+}
+
+func (x *ListFeedbacksForModerationRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *ListFeedbacksForModerationRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFeedbacksForModerationRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListFeedbacksForModerationRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListFeedbacksForModerationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedbacks     []*Feedback            `protobuf:"bytes,1,rep,name=Feedbacks,proto3" json:"Feedbacks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeedbacksForModerationResponse) Reset() {
+	*x = ListFeedbacksForModerationResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeedbacksForModerationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeedbacksForModerationResponse) ProtoMessage() {}
+
+func (x *ListFeedbacksForModerationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeedbacksForModerationResponse.ProtoReflect.Descriptor instead.
+func (*ListFeedbacksForModerationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListFeedbacksForModerationResponse) GetFeedbacks() []*Feedback {
+	if x != nil {
+		return x.Feedbacks
+	}
+	return nil
+}
+
+func (x *ListFeedbacksForModerationResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFeedbacksForModerationResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ListFeedbacksForModerationResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ModerateFeedbackRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ModeratorId string                 `protobuf:"bytes,2,opt,name=moderator_id,json=moderatorId,proto3" json:"moderator_id,omitempty"`
+	// One of published, rejected or hidden.
+	Decision      FeedbackStatus `protobuf:"varint,3,opt,name=decision,proto3,enum=customer.FeedbackStatus" json:"decision,omitempty"`
+	Reason        string         `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateFeedbackRequest) Reset() {
+	*x = ModerateFeedbackRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateFeedbackRequest) ProtoMessage() {}
+
+func (x *ModerateFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*ModerateFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ModerateFeedbackRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateFeedbackRequest) GetModeratorId() string {
+	if x != nil {
+		return x.ModeratorId
+	}
+	return ""
+}
+
+func (x *ModerateFeedbackRequest) GetDecision() FeedbackStatus {
+	if x != nil {
+		return x.Decision
+	}
+	return FeedbackStatus_FEEDBACK_STATUS_UNSPECIFIED
+}
+
+func (x *ModerateFeedbackRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ModerateFeedbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedback      *Feedback              `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateFeedbackResponse) Reset() {
+	*x = ModerateFeedbackResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateFeedbackResponse) ProtoMessage() {}
+
+func (x *ModerateFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*ModerateFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ModerateFeedbackResponse) GetFeedback() *Feedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *ModerateFeedbackResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CustomerRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	Average       float64                `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Distribution  map[int32]int32        `protobuf:"bytes,4,rep,name=distribution,proto3" json:"distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	UpdatedAt     int32                  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Criteria      []*CriterionRating     `protobuf:"bytes,6,rep,name=criteria,proto3" json:"criteria,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerRating) Reset() {
+	*x = CustomerRating{}
+	mi := &file_proto_customer_customer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerRating) ProtoMessage() {}
+
+func (x *CustomerRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerRating.ProtoReflect.Descriptor instead.
+func (*CustomerRating) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CustomerRating) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *CustomerRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *CustomerRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CustomerRating) GetDistribution() map[int32]int32 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *CustomerRating) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *CustomerRating) GetCriteria() []*CriterionRating {
+	if x != nil {
+		return x.Criteria
+	}
+	return nil
+}
+
+type CriterionRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Criterion     string                 `protobuf:"bytes,1,opt,name=criterion,proto3" json:"criterion,omitempty"`
+	Average       float64                `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CriterionRating) Reset() {
+	*x = CriterionRating{}
+	mi := &file_proto_customer_customer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CriterionRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CriterionRating) ProtoMessage() {}
+
+func (x *CriterionRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CriterionRating.ProtoReflect.Descriptor instead.
+func (*CriterionRating) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CriterionRating) GetCriterion() string {
+	if x != nil {
+		return x.Criterion
+	}
+	return ""
+}
+
+func (x *CriterionRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *CriterionRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetCustomerRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CustomerId    string                 `protobuf:"bytes,1,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRatingRequest) Reset() {
+	*x = GetCustomerRatingRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRatingRequest) ProtoMessage() {}
+
+func (x *GetCustomerRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerRatingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetCustomerRatingRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type GetCustomerRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *CustomerRating        `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerRatingResponse) Reset() {
+	*x = GetCustomerRatingResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerRatingResponse) ProtoMessage() {}
+
+func (x *GetCustomerRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerRatingResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerRatingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetCustomerRatingResponse) GetRating() *CustomerRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *GetCustomerRatingResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// Review a customer leaves about the volunteer (user_id) who did the task.
+// With blind review enabled it is hidden until the volunteer has reviewed the
+// customer for the same task or the reveal deadline has passed.
+type VolunteerFeedback struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CustomerId string                 `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	UserId     string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TaskId     string                 `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Rating     int32                  `protobuf:"varint,5,opt,name=rating,proto3" json:"rating,omitempty"`
+	Comment    string                 `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	CreatedAt  int32                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  int32                  `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Screening  *ScreeningVerdict      `protobuf:"bytes,9,opt,name=screening,proto3" json:"screening,omitempty"`
+	// Hidden from listings and search after reports.
+	Hidden        bool `protobuf:"varint,10,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerFeedback) Reset() {
+	*x = VolunteerFeedback{}
+	mi := &file_proto_customer_customer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerFeedback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerFeedback) ProtoMessage() {}
+
+func (x *VolunteerFeedback) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerFeedback.ProtoReflect.Descriptor instead.
+func (*VolunteerFeedback) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VolunteerFeedback) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VolunteerFeedback) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *VolunteerFeedback) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VolunteerFeedback) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *VolunteerFeedback) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *VolunteerFeedback) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *VolunteerFeedback) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *VolunteerFeedback) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *VolunteerFeedback) GetScreening() *ScreeningVerdict {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+func (x *VolunteerFeedback) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type CreateVolunteerFeedbackRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedback      *VolunteerFeedback     `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolunteerFeedbackRequest) Reset() {
+	*x = CreateVolunteerFeedbackRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolunteerFeedbackRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolunteerFeedbackRequest) ProtoMessage() {}
+
+func (x *CreateVolunteerFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolunteerFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*CreateVolunteerFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateVolunteerFeedbackRequest) GetFeedback() *VolunteerFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+type CreateVolunteerFeedbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedback      *VolunteerFeedback     `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVolunteerFeedbackResponse) Reset() {
+	*x = CreateVolunteerFeedbackResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVolunteerFeedbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVolunteerFeedbackResponse) ProtoMessage() {}
+
+func (x *CreateVolunteerFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVolunteerFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*CreateVolunteerFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CreateVolunteerFeedbackResponse) GetFeedback() *VolunteerFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *CreateVolunteerFeedbackResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetVolunteerFeedbacksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At least one of user_id, customer_id and task_id is required.
+	UserId     string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CustomerId string `protobuf:"bytes,2,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	TaskId     string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Limit      int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Ignored when page_token is set.
+	Offset        int32  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken     string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolunteerFeedbacksRequest) Reset() {
+	*x = GetVolunteerFeedbacksRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerFeedbacksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerFeedbacksRequest) ProtoMessage() {}
+
+func (x *GetVolunteerFeedbacksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolunteerFeedbacksRequest.ProtoReflect.Descriptor instead.
+func (*GetVolunteerFeedbacksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetVolunteerFeedbacksRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetVolunteerFeedbacksRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+func (x *GetVolunteerFeedbacksRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *GetVolunteerFeedbacksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetVolunteerFeedbacksRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetVolunteerFeedbacksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetVolunteerFeedbacksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedbacks     []*VolunteerFeedback   `protobuf:"bytes,1,rep,name=Feedbacks,proto3" json:"Feedbacks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolunteerFeedbacksResponse) Reset() {
+	*x = GetVolunteerFeedbacksResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerFeedbacksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerFeedbacksResponse) ProtoMessage() {}
+
+func (x *GetVolunteerFeedbacksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolunteerFeedbacksResponse.ProtoReflect.Descriptor instead.
+func (*GetVolunteerFeedbacksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetVolunteerFeedbacksResponse) GetFeedbacks() []*VolunteerFeedback {
+	if x != nil {
+		return x.Feedbacks
+	}
+	return nil
+}
+
+func (x *GetVolunteerFeedbacksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetVolunteerFeedbacksResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetVolunteerFeedbacksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetVolunteerFeedbackByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolunteerFeedbackByIDRequest) Reset() {
+	*x = GetVolunteerFeedbackByIDRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerFeedbackByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerFeedbackByIDRequest) ProtoMessage() {}
+
+func (x *GetVolunteerFeedbackByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolunteerFeedbackByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetVolunteerFeedbackByIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetVolunteerFeedbackByIDRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetVolunteerFeedbackByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feedback      *VolunteerFeedback     `protobuf:"bytes,1,opt,name=Feedback,proto3" json:"Feedback,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolunteerFeedbackByIDResponse) Reset() {
+	*x = GetVolunteerFeedbackByIDResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerFeedbackByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerFeedbackByIDResponse) ProtoMessage() {}
+
+func (x *GetVolunteerFeedbackByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolunteerFeedbackByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetVolunteerFeedbackByIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetVolunteerFeedbackByIDResponse) GetFeedback() *VolunteerFeedback {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *GetVolunteerFeedbackByIDResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type VolunteerRating struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Average       float64                `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Distribution  map[int32]int32        `protobuf:"bytes,4,rep,name=distribution,proto3" json:"distribution,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerRating) Reset() {
+	*x = VolunteerRating{}
+	mi := &file_proto_customer_customer_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerRating) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerRating) ProtoMessage() {}
+
+func (x *VolunteerRating) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerRating.ProtoReflect.Descriptor instead.
+func (*VolunteerRating) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VolunteerRating) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VolunteerRating) GetAverage() float64 {
+	if x != nil {
+		return x.Average
+	}
+	return 0
+}
+
+func (x *VolunteerRating) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VolunteerRating) GetDistribution() map[int32]int32 {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+type GetVolunteerRatingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolunteerRatingRequest) Reset() {
+	*x = GetVolunteerRatingRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerRatingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerRatingRequest) ProtoMessage() {}
+
+func (x *GetVolunteerRatingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolunteerRatingRequest.ProtoReflect.Descriptor instead.
+func (*GetVolunteerRatingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetVolunteerRatingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetVolunteerRatingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rating        *VolunteerRating       `protobuf:"bytes,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVolunteerRatingResponse) Reset() {
+	*x = GetVolunteerRatingResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVolunteerRatingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVolunteerRatingResponse) ProtoMessage() {}
+
+func (x *GetVolunteerRatingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVolunteerRatingResponse.ProtoReflect.Descriptor instead.
+func (*GetVolunteerRatingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetVolunteerRatingResponse) GetRating() *VolunteerRating {
+	if x != nil {
+		return x.Rating
+	}
+	return nil
+}
+
+func (x *GetVolunteerRatingResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type Customer struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	MaxId      string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	Name       string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	About      string                 `protobuf:"bytes,3,opt,name=about,proto3" json:"about,omitempty"`
+	Type       CustomerType           `protobuf:"varint,4,opt,name=type,proto3,enum=customer.CustomerType" json:"type,omitempty"`
+	CreatedAt  int32                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  int32                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Reputation float64                `protobuf:"fixed64,7,opt,name=reputation,proto3" json:"reputation,omitempty"`
+	Version    int64                  `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Screening  *ScreeningVerdict      `protobuf:"bytes,9,opt,name=screening,proto3" json:"screening,omitempty"`
+	// Hidden from lookups, listings and search after reports.
+	Hidden        bool `protobuf:"varint,10,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Customer) Reset() {
+	*x = Customer{}
+	mi := &file_proto_customer_customer_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Customer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Customer) ProtoMessage() {}
+
+func (x *Customer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Customer.ProtoReflect.Descriptor instead.
+func (*Customer) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Customer) GetMaxId() string {
+	if x != nil {
+		return x.MaxId
+	}
+	return ""
+}
+
+func (x *Customer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Customer) GetAbout() string {
+	if x != nil {
+		return x.About
+	}
+	return ""
+}
+
+func (x *Customer) GetType() CustomerType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomerType_CUSTOMER_TYPE_UNSPECIFIED
+}
+
+func (x *Customer) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Customer) GetUpdatedAt() int32 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Customer) GetReputation() float64 {
+	if x != nil {
+		return x.Reputation
+	}
+	return 0
+}
+
+func (x *Customer) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Customer) GetScreening() *ScreeningVerdict {
+	if x != nil {
+		return x.Screening
+	}
+	return nil
+}
+
+func (x *Customer) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+type ScreeningVerdict struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Action        ScreeningAction        `protobuf:"varint,1,opt,name=action,proto3,enum=customer.ScreeningAction" json:"action,omitempty"`
+	Categories    []ScreeningCategory    `protobuf:"varint,2,rep,packed,name=categories,proto3,enum=customer.ScreeningCategory" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScreeningVerdict) Reset() {
+	*x = ScreeningVerdict{}
+	mi := &file_proto_customer_customer_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreeningVerdict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreeningVerdict) ProtoMessage() {}
+
+func (x *ScreeningVerdict) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreeningVerdict.ProtoReflect.Descriptor instead.
+func (*ScreeningVerdict) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ScreeningVerdict) GetAction() ScreeningAction {
+	if x != nil {
+		return x.Action
+	}
+	return ScreeningAction_SCREENING_ACTION_UNSPECIFIED
+}
+
+func (x *ScreeningVerdict) GetCategories() []ScreeningCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCustomerRequest) Reset() {
+	*x = CreateCustomerRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCustomerRequest) ProtoMessage() {}
+
+func (x *CreateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*CreateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateCustomerRequest) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+type GetCustomersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	MaxId string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	Limit int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Ignored when page_token is set.
+	Offset int32             `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	SortBy CustomerSortField `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=customer.CustomerSortField" json:"sort_by,omitempty"`
+	// Case-insensitive substring match on the customer name.
+	NameQuery     string        `protobuf:"bytes,5,opt,name=name_query,json=nameQuery,proto3" json:"name_query,omitempty"`
+	Type          CustomerType  `protobuf:"varint,6,opt,name=type,proto3,enum=customer.CustomerType" json:"type,omitempty"`
+	CreatedFrom   int32         `protobuf:"varint,7,opt,name=created_from,json=createdFrom,proto3" json:"created_from,omitempty"`
+	CreatedTo     int32         `protobuf:"varint,8,opt,name=created_to,json=createdTo,proto3" json:"created_to,omitempty"`
+	UpdatedFrom   int32         `protobuf:"varint,9,opt,name=updated_from,json=updatedFrom,proto3" json:"updated_from,omitempty"`
+	UpdatedTo     int32         `protobuf:"varint,10,opt,name=updated_to,json=updatedTo,proto3" json:"updated_to,omitempty"`
+	SortDirection SortDirection `protobuf:"varint,11,opt,name=sort_direction,json=sortDirection,proto3,enum=customer.SortDirection" json:"sort_direction,omitempty"`
+	// next_page_token from a previous response. Only supported with the default
+	// created_at descending order.
+	PageToken     string `protobuf:"bytes,12,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomersRequest) Reset() {
+	*x = GetCustomersRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomersRequest) ProtoMessage() {}
+
+func (x *GetCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomersRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCustomersRequest) GetMaxId() string {
+	if x != nil {
+		return x.MaxId
+	}
+	return ""
+}
+
+func (x *GetCustomersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetSortBy() CustomerSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return CustomerSortField_CUSTOMER_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *GetCustomersRequest) GetNameQuery() string {
+	if x != nil {
+		return x.NameQuery
+	}
+	return ""
+}
+
+func (x *GetCustomersRequest) GetType() CustomerType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomerType_CUSTOMER_TYPE_UNSPECIFIED
+}
+
+func (x *GetCustomersRequest) GetCreatedFrom() int32 {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetCreatedTo() int32 {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetUpdatedFrom() int32 {
+	if x != nil {
+		return x.UpdatedFrom
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetUpdatedTo() int32 {
+	if x != nil {
+		return x.UpdatedTo
+	}
+	return 0
+}
+
+func (x *GetCustomersRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_UNSPECIFIED
+}
+
+func (x *GetCustomersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type GetCustomersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customers     []*Customer            `protobuf:"bytes,1,rep,name=Customers,proto3" json:"Customers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomersResponse) Reset() {
+	*x = GetCustomersResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomersResponse) ProtoMessage() {}
+
+func (x *GetCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomersResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCustomersResponse) GetCustomers() []*Customer {
+	if x != nil {
+		return x.Customers
+	}
+	return nil
+}
+
+func (x *GetCustomersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCustomersResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetCustomersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type SearchCustomersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Web-style query over name and about: quoted phrases, "or", "-word".
+	Query         string       `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Type          CustomerType `protobuf:"varint,2,opt,name=type,proto3,enum=customer.CustomerType" json:"type,omitempty"`
+	Limit         int32        `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32        `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCustomersRequest) Reset() {
+	*x = SearchCustomersRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCustomersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCustomersRequest) ProtoMessage() {}
+
+func (x *SearchCustomersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCustomersRequest.ProtoReflect.Descriptor instead.
+func (*SearchCustomersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SearchCustomersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchCustomersRequest) GetType() CustomerType {
+	if x != nil {
+		return x.Type
+	}
+	return CustomerType_CUSTOMER_TYPE_UNSPECIFIED
+}
+
+func (x *SearchCustomersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchCustomersRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CustomerSearchResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Customer *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Rank     float64                `protobuf:"fixed64,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Name and an excerpt of about, HTML-escaped, with matched words wrapped in <b></b>.
+	NameHighlight string `protobuf:"bytes,3,opt,name=name_highlight,json=nameHighlight,proto3" json:"name_highlight,omitempty"`
+	AboutSnippet  string `protobuf:"bytes,4,opt,name=about_snippet,json=aboutSnippet,proto3" json:"about_snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CustomerSearchResult) Reset() {
+	*x = CustomerSearchResult{}
+	mi := &file_proto_customer_customer_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CustomerSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomerSearchResult) ProtoMessage() {}
+
+func (x *CustomerSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomerSearchResult.ProtoReflect.Descriptor instead.
+func (*CustomerSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CustomerSearchResult) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *CustomerSearchResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CustomerSearchResult) GetNameHighlight() string {
+	if x != nil {
+		return x.NameHighlight
+	}
+	return ""
+}
+
+func (x *CustomerSearchResult) GetAboutSnippet() string {
+	if x != nil {
+		return x.AboutSnippet
+	}
+	return ""
+}
+
+type SearchCustomersResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*CustomerSearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchCustomersResponse) Reset() {
+	*x = SearchCustomersResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchCustomersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchCustomersResponse) ProtoMessage() {}
+
+func (x *SearchCustomersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchCustomersResponse.ProtoReflect.Descriptor instead.
+func (*SearchCustomersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SearchCustomersResponse) GetResults() []*CustomerSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchCustomersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SearchCustomersResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type GetCustomerByMaxIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerByMaxIDRequest) Reset() {
+	*x = GetCustomerByMaxIDRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerByMaxIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerByMaxIDRequest) ProtoMessage() {}
+
+func (x *GetCustomerByMaxIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerByMaxIDRequest.ProtoReflect.Descriptor instead.
+func (*GetCustomerByMaxIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetCustomerByMaxIDRequest) GetMaxId() string {
+	if x != nil {
+		return x.MaxId
+	}
+	return ""
+}
+
+type GetCustomerByMaxIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCustomerByMaxIDResponse) Reset() {
+	*x = GetCustomerByMaxIDResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCustomerByMaxIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCustomerByMaxIDResponse) ProtoMessage() {}
+
+func (x *GetCustomerByMaxIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCustomerByMaxIDResponse.ProtoReflect.Descriptor instead.
+func (*GetCustomerByMaxIDResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetCustomerByMaxIDResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *GetCustomerByMaxIDResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type UpdateCustomerRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Customer *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	// Fields of Customer to update: name, about, type. An empty mask updates all of them.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// When set, the update fails with ERROR_CODE_CONFLICT unless the stored version matches.
+	ExpectedVersion int64 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerRequest) Reset() {
+	*x = UpdateCustomerRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerRequest) ProtoMessage() {}
+
+func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UpdateCustomerRequest) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *UpdateCustomerRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateCustomerRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type UpdateCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCustomerResponse) Reset() {
+	*x = UpdateCustomerResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCustomerResponse) ProtoMessage() {}
+
+func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCustomerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *UpdateCustomerResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type DeleteCustomerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	MaxId string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	// When set, the delete fails with ERROR_CODE_CONFLICT unless the stored version matches.
+	ExpectedVersion int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerRequest) Reset() {
+	*x = DeleteCustomerRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerRequest) ProtoMessage() {}
+
+func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DeleteCustomerRequest) GetMaxId() string {
+	if x != nil {
+		return x.MaxId
+	}
+	return ""
+}
+
+func (x *DeleteCustomerRequest) GetExpectedVersion() int64 {
+	if x != nil {
+		return x.ExpectedVersion
+	}
+	return 0
+}
+
+type DeleteCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCustomerResponse) Reset() {
+	*x = DeleteCustomerResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCustomerResponse) ProtoMessage() {}
+
+func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCustomerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteCustomerResponse) GetMaxId() string {
+	if x != nil {
+		return x.MaxId
+	}
+	return ""
+}
+
+func (x *DeleteCustomerResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type RestoreCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCustomerRequest) Reset() {
+	*x = RestoreCustomerRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCustomerRequest) ProtoMessage() {}
+
+func (x *RestoreCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCustomerRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *RestoreCustomerRequest) GetMaxId() string {
+	if x != nil {
+		return x.MaxId
+	}
+	return ""
+}
+
+type RestoreCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCustomerResponse) Reset() {
+	*x = RestoreCustomerResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCustomerResponse) ProtoMessage() {}
+
+func (x *RestoreCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCustomerResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RestoreCustomerResponse) GetCustomer() *Customer {
+	if x != nil {
+		return x.Customer
+	}
+	return nil
+}
+
+func (x *RestoreCustomerResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type PurgeCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeCustomerRequest) Reset() {
+	*x = PurgeCustomerRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCustomerRequest) ProtoMessage() {}
+
+func (x *PurgeCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCustomerRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *PurgeCustomerRequest) GetMaxId() string {
 	if x != nil {
 		return x.MaxId
 	}
 	return ""
 }
 
-func (x *GetCustomersRequest) GetLimit() int32 {
+type PurgeCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeCustomerResponse) Reset() {
+	*x = PurgeCustomerResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCustomerResponse) ProtoMessage() {}
+
+func (x *PurgeCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[57]
 	if x != nil {
-		return x.Limit
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetCustomersRequest) GetOffset() int32 {
+// Deprecated: Use PurgeCustomerResponse.ProtoReflect.Descriptor instead.
+func (*PurgeCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *PurgeCustomerResponse) GetMaxId() string {
 	if x != nil {
-		return x.Offset
+		return x.MaxId
 	}
-	return 0
+	return ""
 }
 
-type GetCustomersResponse struct {
+func (x *PurgeCustomerResponse) GetError() *Error {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+type CreateCustomerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customers     []*Customer            `protobuf:"bytes,1,rep,name=Customers,proto3" json:"Customers,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomersResponse) Reset() {
-	*x = GetCustomersResponse{}
-	mi := &file_proto_customer_customer_proto_msgTypes[12]
+func (x *CreateCustomerResponse) Reset() {
+	*x = CreateCustomerResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomersResponse) String() string {
+func (x *CreateCustomerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomersResponse) ProtoMessage() {}
+func (*CreateCustomerResponse) ProtoMessage() {}
 
-func (x *GetCustomersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[12]
+func (x *CreateCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,54 +4301,295 @@ func (x *GetCustomersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomersResponse.ProtoReflect.Descriptor instead.
-func (*GetCustomersResponse) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CreateCustomerResponse.ProtoReflect.Descriptor instead.
+func (*CreateCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetCustomersResponse) GetCustomers() []*Customer {
+func (x *CreateCustomerResponse) GetCustomer() *Customer {
 	if x != nil {
-		return x.Customers
+		return x.Customer
 	}
 	return nil
 }
 
-func (x *GetCustomersResponse) GetTotal() int32 {
+func (x *CreateCustomerResponse) GetError() *Error {
 	if x != nil {
-		return x.Total
+		return x.Error
+	}
+	return nil
+}
+
+type Report struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Target ReportTarget           `protobuf:"varint,2,opt,name=target,proto3,enum=customer.ReportTarget" json:"target,omitempty"`
+	// Max id of the customer or id of the feedback.
+	TargetId       string       `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ReporterId     string       `protobuf:"bytes,4,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason         ReportReason `protobuf:"varint,5,opt,name=reason,proto3,enum=customer.ReportReason" json:"reason,omitempty"`
+	Comment        string       `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+	Status         ReportStatus `protobuf:"varint,7,opt,name=status,proto3,enum=customer.ReportStatus" json:"status,omitempty"`
+	ResolvedBy     string       `protobuf:"bytes,8,opt,name=resolved_by,json=resolvedBy,proto3" json:"resolved_by,omitempty"`
+	ResolutionNote string       `protobuf:"bytes,9,opt,name=resolution_note,json=resolutionNote,proto3" json:"resolution_note,omitempty"`
+	CreatedAt      int32        `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ResolvedAt     int32        `protobuf:"varint,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_proto_customer_customer_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Report) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Report) GetTarget() ReportTarget {
+	if x != nil {
+		return x.Target
+	}
+	return ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func (x *Report) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Report) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *Report) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *Report) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Report) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *Report) GetResolvedBy() string {
+	if x != nil {
+		return x.ResolvedBy
+	}
+	return ""
+}
+
+func (x *Report) GetResolutionNote() string {
+	if x != nil {
+		return x.ResolutionNote
+	}
+	return ""
+}
+
+func (x *Report) GetCreatedAt() int32 {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *GetCustomersResponse) GetError() *Error {
+func (x *Report) GetResolvedAt() int32 {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return 0
+}
+
+type ReportCustomerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,3,opt,name=reason,proto3,enum=customer.ReportReason" json:"reason,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCustomerRequest) Reset() {
+	*x = ReportCustomerRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCustomerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCustomerRequest) ProtoMessage() {}
+
+func (x *ReportCustomerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCustomerRequest.ProtoReflect.Descriptor instead.
+func (*ReportCustomerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReportCustomerRequest) GetMaxId() string {
+	if x != nil {
+		return x.MaxId
+	}
+	return ""
+}
+
+func (x *ReportCustomerRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *ReportCustomerRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportCustomerRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ReportCustomerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportCustomerResponse) Reset() {
+	*x = ReportCustomerResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportCustomerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportCustomerResponse) ProtoMessage() {}
+
+func (x *ReportCustomerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportCustomerResponse.ProtoReflect.Descriptor instead.
+func (*ReportCustomerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ReportCustomerResponse) GetReport() *Report {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *ReportCustomerResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type GetCustomerByMaxIDRequest struct {
+type ReportFeedbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	FeedbackId    string                 `protobuf:"bytes,1,opt,name=feedback_id,json=feedbackId,proto3" json:"feedback_id,omitempty"`
+	ReporterId    string                 `protobuf:"bytes,2,opt,name=reporter_id,json=reporterId,proto3" json:"reporter_id,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,3,opt,name=reason,proto3,enum=customer.ReportReason" json:"reason,omitempty"`
+	Comment       string                 `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerByMaxIDRequest) Reset() {
-	*x = GetCustomerByMaxIDRequest{}
-	mi := &file_proto_customer_customer_proto_msgTypes[13]
+func (x *ReportFeedbackRequest) Reset() {
+	*x = ReportFeedbackRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerByMaxIDRequest) String() string {
+func (x *ReportFeedbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerByMaxIDRequest) ProtoMessage() {}
+func (*ReportFeedbackRequest) ProtoMessage() {}
 
-func (x *GetCustomerByMaxIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[13]
+func (x *ReportFeedbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,41 +4600,62 @@ func (x *GetCustomerByMaxIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerByMaxIDRequest.ProtoReflect.Descriptor instead.
-func (*GetCustomerByMaxIDRequest) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ReportFeedbackRequest.ProtoReflect.Descriptor instead.
+func (*ReportFeedbackRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetCustomerByMaxIDRequest) GetMaxId() string {
+func (x *ReportFeedbackRequest) GetFeedbackId() string {
 	if x != nil {
-		return x.MaxId
+		return x.FeedbackId
 	}
 	return ""
 }
 
-type GetCustomerByMaxIDResponse struct {
+func (x *ReportFeedbackRequest) GetReporterId() string {
+	if x != nil {
+		return x.ReporterId
+	}
+	return ""
+}
+
+func (x *ReportFeedbackRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ReportFeedbackRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type ReportFeedbackResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Report        *Report                `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
 	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCustomerByMaxIDResponse) Reset() {
-	*x = GetCustomerByMaxIDResponse{}
-	mi := &file_proto_customer_customer_proto_msgTypes[14]
+func (x *ReportFeedbackResponse) Reset() {
+	*x = ReportFeedbackResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCustomerByMaxIDResponse) String() string {
+func (x *ReportFeedbackResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCustomerByMaxIDResponse) ProtoMessage() {}
+func (*ReportFeedbackResponse) ProtoMessage() {}
 
-func (x *GetCustomerByMaxIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[14]
+func (x *ReportFeedbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -977,47 +4666,53 @@ func (x *GetCustomerByMaxIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCustomerByMaxIDResponse.ProtoReflect.Descriptor instead.
-func (*GetCustomerByMaxIDResponse) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ReportFeedbackResponse.ProtoReflect.Descriptor instead.
+func (*ReportFeedbackResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *GetCustomerByMaxIDResponse) GetCustomer() *Customer {
+func (x *ReportFeedbackResponse) GetReport() *Report {
 	if x != nil {
-		return x.Customer
+		return x.Report
 	}
 	return nil
 }
 
-func (x *GetCustomerByMaxIDResponse) GetError() *Error {
+func (x *ReportFeedbackResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type UpdateCustomerRequest struct {
+type ListReportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
+	Target        ReportTarget           `protobuf:"varint,1,opt,name=target,proto3,enum=customer.ReportTarget" json:"target,omitempty"`
+	TargetId      string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Status        ReportStatus           `protobuf:"varint,3,opt,name=status,proto3,enum=customer.ReportStatus" json:"status,omitempty"`
+	Reason        ReportReason           `protobuf:"varint,4,opt,name=reason,proto3,enum=customer.ReportReason" json:"reason,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerRequest) Reset() {
-	*x = UpdateCustomerRequest{}
-	mi := &file_proto_customer_customer_proto_msgTypes[15]
+func (x *ListReportsRequest) Reset() {
+	*x = ListReportsRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerRequest) String() string {
+func (x *ListReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerRequest) ProtoMessage() {}
+func (*ListReportsRequest) ProtoMessage() {}
 
-func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[15]
+func (x *ListReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,41 +4723,85 @@ func (x *UpdateCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *UpdateCustomerRequest) GetCustomer() *Customer {
+func (x *ListReportsRequest) GetTarget() ReportTarget {
 	if x != nil {
-		return x.Customer
+		return x.Target
 	}
-	return nil
+	return ReportTarget_REPORT_TARGET_UNSPECIFIED
 }
 
-type UpdateCustomerResponse struct {
+func (x *ListReportsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *ListReportsRequest) GetStatus() ReportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ListReportsRequest) GetReason() ReportReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReportReason_REPORT_REASON_UNSPECIFIED
+}
+
+func (x *ListReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListReportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	Reports       []*Report              `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Error         *Error                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,4,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCustomerResponse) Reset() {
-	*x = UpdateCustomerResponse{}
-	mi := &file_proto_customer_customer_proto_msgTypes[16]
+func (x *ListReportsResponse) Reset() {
+	*x = ListReportsResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCustomerResponse) String() string {
+func (x *ListReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCustomerResponse) ProtoMessage() {}
+func (*ListReportsResponse) ProtoMessage() {}
 
-func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[16]
+func (x *ListReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,47 +4812,67 @@ func (x *UpdateCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCustomerResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListReportsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *UpdateCustomerResponse) GetCustomer() *Customer {
+func (x *ListReportsResponse) GetReports() []*Report {
 	if x != nil {
-		return x.Customer
+		return x.Reports
 	}
 	return nil
 }
 
-func (x *UpdateCustomerResponse) GetError() *Error {
+func (x *ListReportsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReportsResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type DeleteCustomerRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+func (x *ListReportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Closes all open reports of one target.
+type ResolveReportsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Target     ReportTarget           `protobuf:"varint,1,opt,name=target,proto3,enum=customer.ReportTarget" json:"target,omitempty"`
+	TargetId   string                 `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	ResolverId string                 `protobuf:"bytes,3,opt,name=resolver_id,json=resolverId,proto3" json:"resolver_id,omitempty"`
+	// REPORT_STATUS_UPHELD hides the target, REPORT_STATUS_DISMISSED shows it again.
+	Decision      ReportStatus `protobuf:"varint,4,opt,name=decision,proto3,enum=customer.ReportStatus" json:"decision,omitempty"`
+	Note          string       `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCustomerRequest) Reset() {
-	*x = DeleteCustomerRequest{}
-	mi := &file_proto_customer_customer_proto_msgTypes[17]
+func (x *ResolveReportsRequest) Reset() {
+	*x = ResolveReportsRequest{}
+	mi := &file_proto_customer_customer_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomerRequest) String() string {
+func (x *ResolveReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerRequest) ProtoMessage() {}
+func (*ResolveReportsRequest) ProtoMessage() {}
 
-func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[17]
+func (x *ResolveReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1124,41 +4883,69 @@ func (x *DeleteCustomerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ResolveReportsRequest.ProtoReflect.Descriptor instead.
+func (*ResolveReportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *DeleteCustomerRequest) GetMaxId() string {
+func (x *ResolveReportsRequest) GetTarget() ReportTarget {
 	if x != nil {
-		return x.MaxId
+		return x.Target
+	}
+	return ReportTarget_REPORT_TARGET_UNSPECIFIED
+}
+
+func (x *ResolveReportsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
 	}
 	return ""
 }
 
-type DeleteCustomerResponse struct {
+func (x *ResolveReportsRequest) GetResolverId() string {
+	if x != nil {
+		return x.ResolverId
+	}
+	return ""
+}
+
+func (x *ResolveReportsRequest) GetDecision() ReportStatus {
+	if x != nil {
+		return x.Decision
+	}
+	return ReportStatus_REPORT_STATUS_UNSPECIFIED
+}
+
+func (x *ResolveReportsRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ResolveReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	MaxId         string                 `protobuf:"bytes,1,opt,name=max_id,json=maxId,proto3" json:"max_id,omitempty"`
+	Reports       []*Report              `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
 	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCustomerResponse) Reset() {
-	*x = DeleteCustomerResponse{}
-	mi := &file_proto_customer_customer_proto_msgTypes[18]
+func (x *ResolveReportsResponse) Reset() {
+	*x = ResolveReportsResponse{}
+	mi := &file_proto_customer_customer_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCustomerResponse) String() string {
+func (x *ResolveReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCustomerResponse) ProtoMessage() {}
+func (*ResolveReportsResponse) ProtoMessage() {}
 
-func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[18]
+func (x *ResolveReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1169,48 +4956,52 @@ func (x *DeleteCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCustomerResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ResolveReportsResponse.ProtoReflect.Descriptor instead.
+func (*ResolveReportsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *DeleteCustomerResponse) GetMaxId() string {
+func (x *ResolveReportsResponse) GetReports() []*Report {
 	if x != nil {
-		return x.MaxId
+		return x.Reports
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteCustomerResponse) GetError() *Error {
+func (x *ResolveReportsResponse) GetError() *Error {
 	if x != nil {
 		return x.Error
 	}
 	return nil
 }
 
-type CreateCustomerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Customer      *Customer              `protobuf:"bytes,1,opt,name=Customer,proto3" json:"Customer,omitempty"`
-	Error         *Error                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+type Error struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=customer.ErrorCode" json:"code,omitempty"`
+	Message string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Set with ERROR_CODE_RATE_LIMITED: seconds to wait before trying again.
+	RetryAfterSeconds int32 `protobuf:"varint,3,opt,name=retry_after_seconds,json=retryAfterSeconds,proto3" json:"retry_after_seconds,omitempty"`
+	// Request fields that failed validation.
+	Violations    []*FieldViolation `protobuf:"bytes,4,rep,name=violations,proto3" json:"violations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCustomerResponse) Reset() {
-	*x = CreateCustomerResponse{}
-	mi := &file_proto_customer_customer_proto_msgTypes[19]
+func (x *Error) Reset() {
+	*x = Error{}
+	mi := &file_proto_customer_customer_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCustomerResponse) String() string {
+func (x *Error) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCustomerResponse) ProtoMessage() {}
+func (*Error) ProtoMessage() {}
 
-func (x *CreateCustomerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[19]
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1221,48 +5012,63 @@ func (x *CreateCustomerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCustomerResponse.ProtoReflect.Descriptor instead.
-func (*CreateCustomerResponse) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CreateCustomerResponse) GetCustomer() *Customer {
+func (x *Error) GetCode() ErrorCode {
 	if x != nil {
-		return x.Customer
+		return x.Code
 	}
-	return nil
+	return ErrorCode_ERROR_CODE_UNSPECIFIED
 }
 
-func (x *CreateCustomerResponse) GetError() *Error {
+func (x *Error) GetMessage() string {
 	if x != nil {
-		return x.Error
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Error) GetRetryAfterSeconds() int32 {
+	if x != nil {
+		return x.RetryAfterSeconds
+	}
+	return 0
+}
+
+func (x *Error) GetViolations() []*FieldViolation {
+	if x != nil {
+		return x.Violations
 	}
 	return nil
 }
 
-type Error struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          ErrorCode              `protobuf:"varint,1,opt,name=code,proto3,enum=customer.ErrorCode" json:"code,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type FieldViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path of the field in the request, e.g. Customer.max_id.
+	Field         string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Error) Reset() {
-	*x = Error{}
-	mi := &file_proto_customer_customer_proto_msgTypes[20]
+func (x *FieldViolation) Reset() {
+	*x = FieldViolation{}
+	mi := &file_proto_customer_customer_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Error) String() string {
+func (x *FieldViolation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Error) ProtoMessage() {}
+func (*FieldViolation) ProtoMessage() {}
 
-func (x *Error) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_customer_customer_proto_msgTypes[20]
+func (x *FieldViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_customer_customer_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1273,21 +5079,21 @@ func (x *Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Error.ProtoReflect.Descriptor instead.
-func (*Error) Descriptor() ([]byte, []int) {
-	return file_proto_customer_customer_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use FieldViolation.ProtoReflect.Descriptor instead.
+func (*FieldViolation) Descriptor() ([]byte, []int) {
+	return file_proto_customer_customer_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *Error) GetCode() ErrorCode {
+func (x *FieldViolation) GetField() string {
 	if x != nil {
-		return x.Code
+		return x.Field
 	}
-	return ErrorCode_ERROR_CODE_UNSPECIFIED
+	return ""
 }
 
-func (x *Error) GetMessage() string {
+func (x *FieldViolation) GetDescription() string {
 	if x != nil {
-		return x.Message
+		return x.Description
 	}
 	return ""
 }
@@ -1296,32 +5102,61 @@ var File_proto_customer_customer_proto protoreflect.FileDescriptor
 
 const file_proto_customer_customer_proto_rawDesc = "" +
 	"\n" +
-	"\x1dproto/customer/customer.proto\x12\bcustomer\"(\n" +
+	"\x1dproto/customer/customer.proto\x12\bcustomer\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\"(\n" +
 	"\x16GetFeedbackByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"p\n" +
 	"\x17GetFeedbackByIDResponse\x12.\n" +
 	"\bFeedback\x18\x01 \x01(\v2\x12.customer.FeedbackR\bFeedback\x12%\n" +
-	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"G\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"_\n" +
 	"\x15CreateFeedbackRequest\x12.\n" +
-	"\bFeedback\x18\x01 \x01(\v2\x12.customer.FeedbackR\bFeedback\"o\n" +
+	"\bFeedback\x18\x01 \x01(\v2\x12.customer.FeedbackR\bFeedback\x12\x16\n" +
+	"\x06upsert\x18\x02 \x01(\bR\x06upsert\"o\n" +
 	"\x16CreateFeedbackResponse\x12.\n" +
 	"\bFeedback\x18\x01 \x01(\v2\x12.customer.FeedbackR\bFeedback\x12%\n" +
-	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"u\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xcb\x01\n" +
+	"\x15UpdateFeedbackRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\x120\n" +
+	"\x06scores\x18\x05 \x03(\v2\x18.customer.CriterionScoreR\x06scores\x12%\n" +
+	"\x0ereplace_scores\x18\x06 \x01(\bR\rreplaceScores\"o\n" +
+	"\x16UpdateFeedbackResponse\x12.\n" +
+	"\bFeedback\x18\x01 \x01(\v2\x12.customer.FeedbackR\bFeedback\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"@\n" +
+	"\x15DeleteFeedbackRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"O\n" +
+	"\x16DeleteFeedbackResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\x94\x01\n" +
 	"\x13GetFeedbacksRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\x85\x01\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xad\x01\n" +
 	"\x14GetFeedbacksResponse\x120\n" +
 	"\tFeedbacks\x18\x01 \x03(\v2\x12.customer.FeedbackR\tFeedbacks\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12%\n" +
-	"\x05error\x18\x03 \x01(\v2\x0f.customer.ErrorR\x05error\"I\n" +
+	"\x05error\x18\x03 \x01(\v2\x0f.customer.ErrorR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xea\x01\n" +
 	"\x15CountFeedbacksRequest\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"U\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\x04 \x01(\x05R\tminRating\x12\x1d\n" +
+	"\n" +
+	"max_rating\x18\x05 \x01(\x05R\tmaxRating\x12!\n" +
+	"\fcreated_from\x18\x06 \x01(\x05R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\a \x01(\x05R\tcreatedTo\"U\n" +
 	"\x16CountFeedbacksResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12%\n" +
-	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xdd\x01\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xdb\x03\n" +
 	"\bFeedback\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
 	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x18\n" +
@@ -1333,7 +5168,158 @@ const file_proto_customer_customer_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\x05R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\x05R\tupdatedAt\"\xb5\x01\n" +
+	"updated_at\x18\b \x01(\x05R\tupdatedAt\x120\n" +
+	"\x06status\x18\t \x01(\x0e2\x18.customer.FeedbackStatusR\x06status\x128\n" +
+	"\tscreening\x18\n" +
+	" \x01(\v2\x1a.customer.ScreeningVerdictR\tscreening\x12-\n" +
+	"\x05reply\x18\v \x01(\v2\x17.customer.FeedbackReplyR\x05reply\x120\n" +
+	"\x06scores\x18\f \x03(\v2\x18.customer.CriterionScoreR\x06scores\x12/\n" +
+	"\x05abuse\x18\r \x01(\v2\x19.customer.AbuseAssessmentR\x05abuse\"\xac\x01\n" +
+	"\x0fAbuseAssessment\x12\x14\n" +
+	"\x05score\x18\x01 \x01(\x01R\x05score\x12\x1a\n" +
+	"\bvelocity\x18\x02 \x01(\x01R\bvelocity\x12\x14\n" +
+	"\x05burst\x18\x03 \x01(\x01R\x05burst\x12\x1f\n" +
+	"\vaccount_age\x18\x04 \x01(\x01R\n" +
+	"accountAge\x12\x1c\n" +
+	"\tdeviation\x18\x05 \x01(\x01R\tdeviation\x12\x12\n" +
+	"\x04held\x18\x06 \x01(\bR\x04held\"D\n" +
+	"\x0eCriterionScore\x12\x1c\n" +
+	"\tcriterion\x18\x01 \x01(\tR\tcriterion\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x05R\x05score\"\xed\x01\n" +
+	"\rFeedbackReply\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vfeedback_id\x18\x02 \x01(\tR\n" +
+	"feedbackId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x05R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x05R\tupdatedAt\x128\n" +
+	"\tscreening\x18\a \x01(\v2\x1a.customer.ScreeningVerdictR\tscreening\"n\n" +
+	"\x16ReplyToFeedbackRequest\x12\x1f\n" +
+	"\vfeedback_id\x18\x01 \x01(\tR\n" +
+	"feedbackId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"o\n" +
+	"\x17ReplyToFeedbackResponse\x12-\n" +
+	"\x05reply\x18\x01 \x01(\v2\x17.customer.FeedbackReplyR\x05reply\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"r\n" +
+	"\x1aUpdateFeedbackReplyRequest\x12\x1f\n" +
+	"\vfeedback_id\x18\x01 \x01(\tR\n" +
+	"feedbackId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"s\n" +
+	"\x1bUpdateFeedbackReplyResponse\x12-\n" +
+	"\x05reply\x18\x01 \x01(\v2\x17.customer.FeedbackReplyR\x05reply\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"^\n" +
+	"\x1aDeleteFeedbackReplyRequest\x12\x1f\n" +
+	"\vfeedback_id\x18\x01 \x01(\tR\n" +
+	"feedbackId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\"e\n" +
+	"\x1bDeleteFeedbackReplyResponse\x12\x1f\n" +
+	"\vfeedback_id\x18\x01 \x01(\tR\n" +
+	"feedbackId\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xc3\x01\n" +
+	"!ListFeedbacksForModerationRequest\x120\n" +
+	"\x06status\x18\x01 \x01(\x0e2\x18.customer.FeedbackStatusR\x06status\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xbb\x01\n" +
+	"\"ListFeedbacksForModerationResponse\x120\n" +
+	"\tFeedbacks\x18\x01 \x03(\v2\x12.customer.FeedbackR\tFeedbacks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12%\n" +
+	"\x05error\x18\x03 \x01(\v2\x0f.customer.ErrorR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x9a\x01\n" +
+	"\x17ModerateFeedbackRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fmoderator_id\x18\x02 \x01(\tR\vmoderatorId\x124\n" +
+	"\bdecision\x18\x03 \x01(\x0e2\x18.customer.FeedbackStatusR\bdecision\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"q\n" +
+	"\x18ModerateFeedbackResponse\x12.\n" +
+	"\bFeedback\x18\x01 \x01(\v2\x12.customer.FeedbackR\bFeedback\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xc8\x02\n" +
+	"\x0eCustomerRating\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\x12\x18\n" +
+	"\aaverage\x18\x02 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12N\n" +
+	"\fdistribution\x18\x04 \x03(\v2*.customer.CustomerRating.DistributionEntryR\fdistribution\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\x05R\tupdatedAt\x125\n" +
+	"\bcriteria\x18\x06 \x03(\v2\x19.customer.CriterionRatingR\bcriteria\x1a?\n" +
+	"\x11DistributionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"_\n" +
+	"\x0fCriterionRating\x12\x1c\n" +
+	"\tcriterion\x18\x01 \x01(\tR\tcriterion\x12\x18\n" +
+	"\aaverage\x18\x02 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\";\n" +
+	"\x18GetCustomerRatingRequest\x12\x1f\n" +
+	"\vcustomer_id\x18\x01 \x01(\tR\n" +
+	"customerId\"t\n" +
+	"\x19GetCustomerRatingResponse\x120\n" +
+	"\x06rating\x18\x01 \x01(\v2\x18.customer.CustomerRatingR\x06rating\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xb8\x02\n" +
+	"\x11VolunteerFeedback\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x17\n" +
+	"\atask_id\x18\x04 \x01(\tR\x06taskId\x12\x16\n" +
+	"\x06rating\x18\x05 \x01(\x05R\x06rating\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x05R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\x05R\tupdatedAt\x128\n" +
+	"\tscreening\x18\t \x01(\v2\x1a.customer.ScreeningVerdictR\tscreening\x12\x16\n" +
+	"\x06hidden\x18\n" +
+	" \x01(\bR\x06hidden\"Y\n" +
+	"\x1eCreateVolunteerFeedbackRequest\x127\n" +
+	"\bFeedback\x18\x01 \x01(\v2\x1b.customer.VolunteerFeedbackR\bFeedback\"\x81\x01\n" +
+	"\x1fCreateVolunteerFeedbackResponse\x127\n" +
+	"\bFeedback\x18\x01 \x01(\v2\x1b.customer.VolunteerFeedbackR\bFeedback\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xbe\x01\n" +
+	"\x1cGetVolunteerFeedbacksRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcustomer_id\x18\x02 \x01(\tR\n" +
+	"customerId\x12\x17\n" +
+	"\atask_id\x18\x03 \x01(\tR\x06taskId\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xbf\x01\n" +
+	"\x1dGetVolunteerFeedbacksResponse\x129\n" +
+	"\tFeedbacks\x18\x01 \x03(\v2\x1b.customer.VolunteerFeedbackR\tFeedbacks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12%\n" +
+	"\x05error\x18\x03 \x01(\v2\x0f.customer.ErrorR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"1\n" +
+	"\x1fGetVolunteerFeedbackByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x82\x01\n" +
+	" GetVolunteerFeedbackByIDResponse\x127\n" +
+	"\bFeedback\x18\x01 \x01(\v2\x1b.customer.VolunteerFeedbackR\bFeedback\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xec\x01\n" +
+	"\x0fVolunteerRating\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x18\n" +
+	"\aaverage\x18\x02 \x01(\x01R\aaverage\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12O\n" +
+	"\fdistribution\x18\x04 \x03(\v2+.customer.VolunteerRating.DistributionEntryR\fdistribution\x1a?\n" +
+	"\x11DistributionEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x05R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\"4\n" +
+	"\x19GetVolunteerRatingRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"v\n" +
+	"\x1aGetVolunteerRatingResponse\x121\n" +
+	"\x06rating\x18\x01 \x01(\v2\x19.customer.VolunteerRatingR\x06rating\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xc1\x02\n" +
 	"\bCustomer\x12\x15\n" +
 	"\x06max_id\x18\x01 \x01(\tR\x05maxId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -1342,59 +5328,248 @@ const file_proto_customer_customer_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\x05R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\x05R\tupdatedAt\"G\n" +
+	"updated_at\x18\x06 \x01(\x05R\tupdatedAt\x12\x1e\n" +
+	"\n" +
+	"reputation\x18\a \x01(\x01R\n" +
+	"reputation\x12\x18\n" +
+	"\aversion\x18\b \x01(\x03R\aversion\x128\n" +
+	"\tscreening\x18\t \x01(\v2\x1a.customer.ScreeningVerdictR\tscreening\x12\x16\n" +
+	"\x06hidden\x18\n" +
+	" \x01(\bR\x06hidden\"\x82\x01\n" +
+	"\x10ScreeningVerdict\x121\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x19.customer.ScreeningActionR\x06action\x12;\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\x0e2\x1b.customer.ScreeningCategoryR\n" +
+	"categories\"G\n" +
 	"\x15CreateCustomerRequest\x12.\n" +
-	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\"Z\n" +
+	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\"\xbe\x03\n" +
 	"\x13GetCustomersRequest\x12\x15\n" +
 	"\x06max_id\x18\x01 \x01(\tR\x05maxId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
-	"\x06offset\x18\x03 \x01(\x05R\x06offset\"\x85\x01\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\x124\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x1b.customer.CustomerSortFieldR\x06sortBy\x12\x1d\n" +
+	"\n" +
+	"name_query\x18\x05 \x01(\tR\tnameQuery\x12*\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x16.customer.CustomerTypeR\x04type\x12!\n" +
+	"\fcreated_from\x18\a \x01(\x05R\vcreatedFrom\x12\x1d\n" +
+	"\n" +
+	"created_to\x18\b \x01(\x05R\tcreatedTo\x12!\n" +
+	"\fupdated_from\x18\t \x01(\x05R\vupdatedFrom\x12\x1d\n" +
+	"\n" +
+	"updated_to\x18\n" +
+	" \x01(\x05R\tupdatedTo\x12>\n" +
+	"\x0esort_direction\x18\v \x01(\x0e2\x17.customer.SortDirectionR\rsortDirection\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\f \x01(\tR\tpageToken\"\xad\x01\n" +
 	"\x14GetCustomersResponse\x120\n" +
 	"\tCustomers\x18\x01 \x03(\v2\x12.customer.CustomerR\tCustomers\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12%\n" +
+	"\x05error\x18\x03 \x01(\v2\x0f.customer.ErrorR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\x88\x01\n" +
+	"\x16SearchCustomersRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12*\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x16.customer.CustomerTypeR\x04type\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xa6\x01\n" +
+	"\x14CustomerSearchResult\x12.\n" +
+	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\x12\x12\n" +
+	"\x04rank\x18\x02 \x01(\x01R\x04rank\x12%\n" +
+	"\x0ename_highlight\x18\x03 \x01(\tR\rnameHighlight\x12#\n" +
+	"\rabout_snippet\x18\x04 \x01(\tR\faboutSnippet\"\x90\x01\n" +
+	"\x17SearchCustomersResponse\x128\n" +
+	"\aresults\x18\x01 \x03(\v2\x1e.customer.CustomerSearchResultR\aresults\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12%\n" +
 	"\x05error\x18\x03 \x01(\v2\x0f.customer.ErrorR\x05error\"2\n" +
 	"\x19GetCustomerByMaxIDRequest\x12\x15\n" +
 	"\x06max_id\x18\x01 \x01(\tR\x05maxId\"s\n" +
 	"\x1aGetCustomerByMaxIDResponse\x12.\n" +
 	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\x12%\n" +
-	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"G\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xaf\x01\n" +
 	"\x15UpdateCustomerRequest\x12.\n" +
-	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\"o\n" +
+	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12)\n" +
+	"\x10expected_version\x18\x03 \x01(\x03R\x0fexpectedVersion\"o\n" +
 	"\x16UpdateCustomerResponse\x12.\n" +
 	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\x12%\n" +
-	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\".\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"Y\n" +
 	"\x15DeleteCustomerRequest\x12\x15\n" +
-	"\x06max_id\x18\x01 \x01(\tR\x05maxId\"V\n" +
+	"\x06max_id\x18\x01 \x01(\tR\x05maxId\x12)\n" +
+	"\x10expected_version\x18\x02 \x01(\x03R\x0fexpectedVersion\"V\n" +
 	"\x16DeleteCustomerResponse\x12\x15\n" +
 	"\x06max_id\x18\x01 \x01(\tR\x05maxId\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"/\n" +
+	"\x16RestoreCustomerRequest\x12\x15\n" +
+	"\x06max_id\x18\x01 \x01(\tR\x05maxId\"p\n" +
+	"\x17RestoreCustomerResponse\x12.\n" +
+	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"-\n" +
+	"\x14PurgeCustomerRequest\x12\x15\n" +
+	"\x06max_id\x18\x01 \x01(\tR\x05maxId\"U\n" +
+	"\x15PurgeCustomerResponse\x12\x15\n" +
+	"\x06max_id\x18\x01 \x01(\tR\x05maxId\x12%\n" +
 	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"o\n" +
 	"\x16CreateCustomerResponse\x12.\n" +
 	"\bCustomer\x18\x01 \x01(\v2\x12.customer.CustomerR\bCustomer\x12%\n" +
-	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"J\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\x8a\x03\n" +
+	"\x06Report\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x06target\x18\x02 \x01(\x0e2\x16.customer.ReportTargetR\x06target\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\tR\btargetId\x12\x1f\n" +
+	"\vreporter_id\x18\x04 \x01(\tR\n" +
+	"reporterId\x12.\n" +
+	"\x06reason\x18\x05 \x01(\x0e2\x16.customer.ReportReasonR\x06reason\x12\x18\n" +
+	"\acomment\x18\x06 \x01(\tR\acomment\x12.\n" +
+	"\x06status\x18\a \x01(\x0e2\x16.customer.ReportStatusR\x06status\x12\x1f\n" +
+	"\vresolved_by\x18\b \x01(\tR\n" +
+	"resolvedBy\x12'\n" +
+	"\x0fresolution_note\x18\t \x01(\tR\x0eresolutionNote\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x05R\tcreatedAt\x12\x1f\n" +
+	"\vresolved_at\x18\v \x01(\x05R\n" +
+	"resolvedAt\"\x99\x01\n" +
+	"\x15ReportCustomerRequest\x12\x15\n" +
+	"\x06max_id\x18\x01 \x01(\tR\x05maxId\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\tR\n" +
+	"reporterId\x12.\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x16.customer.ReportReasonR\x06reason\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"i\n" +
+	"\x16ReportCustomerResponse\x12(\n" +
+	"\x06report\x18\x01 \x01(\v2\x10.customer.ReportR\x06report\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xa3\x01\n" +
+	"\x15ReportFeedbackRequest\x12\x1f\n" +
+	"\vfeedback_id\x18\x01 \x01(\tR\n" +
+	"feedbackId\x12\x1f\n" +
+	"\vreporter_id\x18\x02 \x01(\tR\n" +
+	"reporterId\x12.\n" +
+	"\x06reason\x18\x03 \x01(\x0e2\x16.customer.ReportReasonR\x06reason\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"i\n" +
+	"\x16ReportFeedbackResponse\x12(\n" +
+	"\x06report\x18\x01 \x01(\v2\x10.customer.ReportR\x06report\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\x8e\x02\n" +
+	"\x12ListReportsRequest\x12.\n" +
+	"\x06target\x18\x01 \x01(\x0e2\x16.customer.ReportTargetR\x06target\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12.\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x16.customer.ReportStatusR\x06status\x12.\n" +
+	"\x06reason\x18\x04 \x01(\x0e2\x16.customer.ReportReasonR\x06reason\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x05R\x06offset\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\"\xa6\x01\n" +
+	"\x13ListReportsResponse\x12*\n" +
+	"\areports\x18\x01 \x03(\v2\x10.customer.ReportR\areports\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12%\n" +
+	"\x05error\x18\x03 \x01(\v2\x0f.customer.ErrorR\x05error\x12&\n" +
+	"\x0fnext_page_token\x18\x04 \x01(\tR\rnextPageToken\"\xcd\x01\n" +
+	"\x15ResolveReportsRequest\x12.\n" +
+	"\x06target\x18\x01 \x01(\x0e2\x16.customer.ReportTargetR\x06target\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\tR\btargetId\x12\x1f\n" +
+	"\vresolver_id\x18\x03 \x01(\tR\n" +
+	"resolverId\x122\n" +
+	"\bdecision\x18\x04 \x01(\x0e2\x16.customer.ReportStatusR\bdecision\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"k\n" +
+	"\x16ResolveReportsResponse\x12*\n" +
+	"\areports\x18\x01 \x03(\v2\x10.customer.ReportR\areports\x12%\n" +
+	"\x05error\x18\x02 \x01(\v2\x0f.customer.ErrorR\x05error\"\xb4\x01\n" +
 	"\x05Error\x12'\n" +
 	"\x04code\x18\x01 \x01(\x0e2\x13.customer.ErrorCodeR\x04code\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*g\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12.\n" +
+	"\x13retry_after_seconds\x18\x03 \x01(\x05R\x11retryAfterSeconds\x128\n" +
+	"\n" +
+	"violations\x18\x04 \x03(\v2\x18.customer.FieldViolationR\n" +
+	"violations\"H\n" +
+	"\x0eFieldViolation\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription*\xa7\x01\n" +
+	"\x0eFeedbackStatus\x12\x1f\n" +
+	"\x1bFEEDBACK_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17FEEDBACK_STATUS_PENDING\x10\x01\x12\x1d\n" +
+	"\x19FEEDBACK_STATUS_PUBLISHED\x10\x02\x12\x1c\n" +
+	"\x18FEEDBACK_STATUS_REJECTED\x10\x03\x12\x1a\n" +
+	"\x16FEEDBACK_STATUS_HIDDEN\x10\x04*\xa6\x01\n" +
+	"\x0fScreeningAction\x12 \n" +
+	"\x1cSCREENING_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16SCREENING_ACTION_ALLOW\x10\x01\x12\x19\n" +
+	"\x15SCREENING_ACTION_MASK\x10\x02\x12\x1d\n" +
+	"\x19SCREENING_ACTION_MODERATE\x10\x03\x12\x1b\n" +
+	"\x17SCREENING_ACTION_REJECT\x10\x04*\xb1\x01\n" +
+	"\x11ScreeningCategory\x12\"\n" +
+	"\x1eSCREENING_CATEGORY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aSCREENING_CATEGORY_OBSCENE\x10\x01\x12\x1e\n" +
+	"\x1aSCREENING_CATEGORY_CONTACT\x10\x02\x12\x1b\n" +
+	"\x17SCREENING_CATEGORY_LINK\x10\x03\x12\x1b\n" +
+	"\x17SCREENING_CATEGORY_SPAM\x10\x04*g\n" +
 	"\fCustomerType\x12\x1d\n" +
 	"\x19CUSTOMER_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18CUSTOMER_TYPE_INDIVIDUAL\x10\x01\x12\x1a\n" +
-	"\x16CUSTOMER_TYPE_BUSINESS\x10\x02*\xaf\x01\n" +
+	"\x16CUSTOMER_TYPE_BUSINESS\x10\x02*\xc2\x01\n" +
+	"\x11CustomerSortField\x12#\n" +
+	"\x1fCUSTOMER_SORT_FIELD_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eCUSTOMER_SORT_FIELD_CREATED_AT\x10\x01\x12\"\n" +
+	"\x1eCUSTOMER_SORT_FIELD_REPUTATION\x10\x02\x12\"\n" +
+	"\x1eCUSTOMER_SORT_FIELD_UPDATED_AT\x10\x03\x12\x1c\n" +
+	"\x18CUSTOMER_SORT_FIELD_NAME\x10\x04*`\n" +
+	"\rSortDirection\x12\x1e\n" +
+	"\x1aSORT_DIRECTION_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x01\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x02*e\n" +
+	"\fReportTarget\x12\x1d\n" +
+	"\x19REPORT_TARGET_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16REPORT_TARGET_CUSTOMER\x10\x01\x12\x1a\n" +
+	"\x16REPORT_TARGET_FEEDBACK\x10\x02*\xac\x01\n" +
+	"\fReportReason\x12\x1d\n" +
+	"\x19REPORT_REASON_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_REASON_SPAM\x10\x01\x12\x17\n" +
+	"\x13REPORT_REASON_FRAUD\x10\x02\x12\x1b\n" +
+	"\x17REPORT_REASON_OFFENSIVE\x10\x03\x12\x16\n" +
+	"\x12REPORT_REASON_FAKE\x10\x04\x12\x17\n" +
+	"\x13REPORT_REASON_OTHER\x10\x05*|\n" +
+	"\fReportStatus\x12\x1d\n" +
+	"\x19REPORT_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12REPORT_STATUS_OPEN\x10\x01\x12\x18\n" +
+	"\x14REPORT_STATUS_UPHELD\x10\x02\x12\x1b\n" +
+	"\x17REPORT_STATUS_DISMISSED\x10\x03*\x9b\x02\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15ERROR_CODE_VALIDATION\x10\x01\x12\x18\n" +
 	"\x14ERROR_CODE_NOT_FOUND\x10\x02\x12\x17\n" +
 	"\x13ERROR_CODE_INTERNAL\x10\x03\x12\x1d\n" +
 	"\x19ERROR_CODE_ALREADY_EXISTS\x10\x04\x12\x19\n" +
-	"\x15ERROR_CODE_NOT_ENOUGH\x10\x052\x91\x06\n" +
-	"\x0fCustomerService\x12S\n" +
-	"\x0eCreateCustomer\x12\x1f.customer.CreateCustomerRequest\x1a .customer.CreateCustomerResponse\x12M\n" +
-	"\fGetCustomers\x12\x1d.customer.GetCustomersRequest\x1a\x1e.customer.GetCustomersResponse\x12_\n" +
-	"\x12GetCustomerByMaxID\x12#.customer.GetCustomerByMaxIDRequest\x1a$.customer.GetCustomerByMaxIDResponse\x12S\n" +
-	"\x0eUpdateCustomer\x12\x1f.customer.UpdateCustomerRequest\x1a .customer.UpdateCustomerResponse\x12S\n" +
-	"\x0eDeleteCustomer\x12\x1f.customer.DeleteCustomerRequest\x1a .customer.DeleteCustomerResponse\x12S\n" +
-	"\x0eCreateFeedback\x12\x1f.customer.CreateFeedbackRequest\x1a .customer.CreateFeedbackResponse\x12M\n" +
-	"\fGetFeedbacks\x12\x1d.customer.GetFeedbacksRequest\x1a\x1e.customer.GetFeedbacksResponse\x12S\n" +
-	"\x0eCountFeedbacks\x12\x1f.customer.CountFeedbacksRequest\x1a .customer.CountFeedbacksResponse\x12V\n" +
-	"\x0fGetFeedbackByID\x12 .customer.GetFeedbackByIDRequest\x1a!.customer.GetFeedbackByIDResponseB?Z=DobrikaDev/customer-service/internal/generated/proto/customerb\x06proto3"
+	"\x15ERROR_CODE_NOT_ENOUGH\x10\x05\x12\x17\n" +
+	"\x13ERROR_CODE_CONFLICT\x10\x06\x12\x18\n" +
+	"\x14ERROR_CODE_FORBIDDEN\x10\a\x12\x1a\n" +
+	"\x16ERROR_CODE_UNAVAILABLE\x10\b\x12\x1b\n" +
+	"\x17ERROR_CODE_RATE_LIMITED\x10\t2\xce\x1a\n" +
+	"\x0fCustomerService\x12t\n" +
+	"\x0eCreateCustomer\x12\x1f.customer.CreateCustomerRequest\x1a .customer.CreateCustomerResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\bCustomer\"\r/v1/customers\x12d\n" +
+	"\fGetCustomers\x12\x1d.customer.GetCustomersRequest\x1a\x1e.customer.GetCustomersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/customers\x12\x7f\n" +
+	"\x12GetCustomerByMaxID\x12#.customer.GetCustomerByMaxIDRequest\x1a$.customer.GetCustomerByMaxIDResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/customers/{max_id}\x12t\n" +
+	"\x0fSearchCustomers\x12 .customer.SearchCustomersRequest\x1a!.customer.SearchCustomersResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/customers:search\x12\x86\x01\n" +
+	"\x0eUpdateCustomer\x12\x1f.customer.UpdateCustomerRequest\x1a .customer.UpdateCustomerResponse\"1\x82\xd3\xe4\x93\x02+:\bCustomer2\x1f/v1/customers/{Customer.max_id}\x12s\n" +
+	"\x0eDeleteCustomer\x12\x1f.customer.DeleteCustomerRequest\x1a .customer.DeleteCustomerResponse\"\x1e\x82\xd3\xe4\x93\x02\x18*\x16/v1/customers/{max_id}\x12\x81\x01\n" +
+	"\x0fRestoreCustomer\x12 .customer.RestoreCustomerRequest\x1a!.customer.RestoreCustomerResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/customers/{max_id}:restore\x12P\n" +
+	"\rPurgeCustomer\x12\x1e.customer.PurgeCustomerRequest\x1a\x1f.customer.PurgeCustomerResponse\x12m\n" +
+	"\x0eCreateFeedback\x12\x1f.customer.CreateFeedbackRequest\x1a .customer.CreateFeedbackResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/feedbacks\x12d\n" +
+	"\fGetFeedbacks\x12\x1d.customer.GetFeedbacksRequest\x1a\x1e.customer.GetFeedbacksResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/feedbacks\x12p\n" +
+	"\x0eCountFeedbacks\x12\x1f.customer.CountFeedbacksRequest\x1a .customer.CountFeedbacksResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/feedbacks:count\x12r\n" +
+	"\x0fGetFeedbackByID\x12 .customer.GetFeedbackByIDRequest\x1a!.customer.GetFeedbackByIDResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/feedbacks/{id}\x12r\n" +
+	"\x0eUpdateFeedback\x12\x1f.customer.UpdateFeedbackRequest\x1a .customer.UpdateFeedbackResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/v1/feedbacks/{id}\x12o\n" +
+	"\x0eDeleteFeedback\x12\x1f.customer.DeleteFeedbackRequest\x1a .customer.DeleteFeedbackResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/feedbacks/{id}\x12\x84\x01\n" +
+	"\x0fReplyToFeedback\x12 .customer.ReplyToFeedbackRequest\x1a!.customer.ReplyToFeedbackResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/feedbacks/{feedback_id}/reply\x12\x90\x01\n" +
+	"\x13UpdateFeedbackReply\x12$.customer.UpdateFeedbackReplyRequest\x1a%.customer.UpdateFeedbackReplyResponse\",\x82\xd3\xe4\x93\x02&:\x01*2!/v1/feedbacks/{feedback_id}/reply\x12\x8d\x01\n" +
+	"\x13DeleteFeedbackReply\x12$.customer.DeleteFeedbackReplyRequest\x1a%.customer.DeleteFeedbackReplyResponse\")\x82\xd3\xe4\x93\x02#*!/v1/feedbacks/{feedback_id}/reply\x12w\n" +
+	"\x1aListFeedbacksForModeration\x12+.customer.ListFeedbacksForModerationRequest\x1a,.customer.ListFeedbacksForModerationResponse\x12Y\n" +
+	"\x10ModerateFeedback\x12!.customer.ModerateFeedbackRequest\x1a\".customer.ModerateFeedbackResponse\x12\x88\x01\n" +
+	"\x11GetCustomerRating\x12\".customer.GetCustomerRatingRequest\x1a#.customer.GetCustomerRatingResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/v1/customers/{customer_id}/rating\x12\x92\x01\n" +
+	"\x17CreateVolunteerFeedback\x12(.customer.CreateVolunteerFeedbackRequest\x1a).customer.CreateVolunteerFeedbackResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/volunteer-feedbacks\x12\x89\x01\n" +
+	"\x15GetVolunteerFeedbacks\x12&.customer.GetVolunteerFeedbacksRequest\x1a'.customer.GetVolunteerFeedbacksResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/volunteer-feedbacks\x12\x97\x01\n" +
+	"\x18GetVolunteerFeedbackByID\x12).customer.GetVolunteerFeedbackByIDRequest\x1a*.customer.GetVolunteerFeedbackByIDResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/volunteer-feedbacks/{id}\x12\x88\x01\n" +
+	"\x12GetVolunteerRating\x12#.customer.GetVolunteerRatingRequest\x1a$.customer.GetVolunteerRatingResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/v1/volunteers/{user_id}/rating\x12~\n" +
+	"\x0eReportCustomer\x12\x1f.customer.ReportCustomerRequest\x1a .customer.ReportCustomerResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/customers/{max_id}/reports\x12\x83\x01\n" +
+	"\x0eReportFeedback\x12\x1f.customer.ReportFeedbackRequest\x1a .customer.ReportFeedbackResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/feedbacks/{feedback_id}/reports\x12J\n" +
+	"\vListReports\x12\x1c.customer.ListReportsRequest\x1a\x1d.customer.ListReportsResponse\x12S\n" +
+	"\x0eResolveReports\x12\x1f.customer.ResolveReportsRequest\x1a .customer.ResolveReportsResponseB?Z=DobrikaDev/customer-service/internal/generated/proto/customerb\x06proto3"
 
 var (
 	file_proto_customer_customer_proto_rawDescOnce sync.Once
@@ -1408,78 +5583,245 @@ func file_proto_customer_customer_proto_rawDescGZIP() []byte {
 	return file_proto_customer_customer_proto_rawDescData
 }
 
-var file_proto_customer_customer_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_proto_customer_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_proto_customer_customer_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_proto_customer_customer_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
 var file_proto_customer_customer_proto_goTypes = []any{
-	(CustomerType)(0),                  // 0: customer.CustomerType
-	(ErrorCode)(0),                     // 1: customer.ErrorCode
-	(*GetFeedbackByIDRequest)(nil),     // 2: customer.GetFeedbackByIDRequest
-	(*GetFeedbackByIDResponse)(nil),    // 3: customer.GetFeedbackByIDResponse
-	(*CreateFeedbackRequest)(nil),      // 4: customer.CreateFeedbackRequest
-	(*CreateFeedbackResponse)(nil),     // 5: customer.CreateFeedbackResponse
-	(*GetFeedbacksRequest)(nil),        // 6: customer.GetFeedbacksRequest
-	(*GetFeedbacksResponse)(nil),       // 7: customer.GetFeedbacksResponse
-	(*CountFeedbacksRequest)(nil),      // 8: customer.CountFeedbacksRequest
-	(*CountFeedbacksResponse)(nil),     // 9: customer.CountFeedbacksResponse
-	(*Feedback)(nil),                   // 10: customer.Feedback
-	(*Customer)(nil),                   // 11: customer.Customer
-	(*CreateCustomerRequest)(nil),      // 12: customer.CreateCustomerRequest
-	(*GetCustomersRequest)(nil),        // 13: customer.GetCustomersRequest
-	(*GetCustomersResponse)(nil),       // 14: customer.GetCustomersResponse
-	(*GetCustomerByMaxIDRequest)(nil),  // 15: customer.GetCustomerByMaxIDRequest
-	(*GetCustomerByMaxIDResponse)(nil), // 16: customer.GetCustomerByMaxIDResponse
-	(*UpdateCustomerRequest)(nil),      // 17: customer.UpdateCustomerRequest
-	(*UpdateCustomerResponse)(nil),     // 18: customer.UpdateCustomerResponse
-	(*DeleteCustomerRequest)(nil),      // 19: customer.DeleteCustomerRequest
-	(*DeleteCustomerResponse)(nil),     // 20: customer.DeleteCustomerResponse
-	(*CreateCustomerResponse)(nil),     // 21: customer.CreateCustomerResponse
-	(*Error)(nil),                      // 22: customer.Error
+	(FeedbackStatus)(0),                        // 0: customer.FeedbackStatus
+	(ScreeningAction)(0),                       // 1: customer.ScreeningAction
+	(ScreeningCategory)(0),                     // 2: customer.ScreeningCategory
+	(CustomerType)(0),                          // 3: customer.CustomerType
+	(CustomerSortField)(0),                     // 4: customer.CustomerSortField
+	(SortDirection)(0),                         // 5: customer.SortDirection
+	(ReportTarget)(0),                          // 6: customer.ReportTarget
+	(ReportReason)(0),                          // 7: customer.ReportReason
+	(ReportStatus)(0),                          // 8: customer.ReportStatus
+	(ErrorCode)(0),                             // 9: customer.ErrorCode
+	(*GetFeedbackByIDRequest)(nil),             // 10: customer.GetFeedbackByIDRequest
+	(*GetFeedbackByIDResponse)(nil),            // 11: customer.GetFeedbackByIDResponse
+	(*CreateFeedbackRequest)(nil),              // 12: customer.CreateFeedbackRequest
+	(*CreateFeedbackResponse)(nil),             // 13: customer.CreateFeedbackResponse
+	(*UpdateFeedbackRequest)(nil),              // 14: customer.UpdateFeedbackRequest
+	(*UpdateFeedbackResponse)(nil),             // 15: customer.UpdateFeedbackResponse
+	(*DeleteFeedbackRequest)(nil),              // 16: customer.DeleteFeedbackRequest
+	(*DeleteFeedbackResponse)(nil),             // 17: customer.DeleteFeedbackResponse
+	(*GetFeedbacksRequest)(nil),                // 18: customer.GetFeedbacksRequest
+	(*GetFeedbacksResponse)(nil),               // 19: customer.GetFeedbacksResponse
+	(*CountFeedbacksRequest)(nil),              // 20: customer.CountFeedbacksRequest
+	(*CountFeedbacksResponse)(nil),             // 21: customer.CountFeedbacksResponse
+	(*Feedback)(nil),                           // 22: customer.Feedback
+	(*AbuseAssessment)(nil),                    // 23: customer.AbuseAssessment
+	(*CriterionScore)(nil),                     // 24: customer.CriterionScore
+	(*FeedbackReply)(nil),                      // 25: customer.FeedbackReply
+	(*ReplyToFeedbackRequest)(nil),             // 26: customer.ReplyToFeedbackRequest
+	(*ReplyToFeedbackResponse)(nil),            // 27: customer.ReplyToFeedbackResponse
+	(*UpdateFeedbackReplyRequest)(nil),         // 28: customer.UpdateFeedbackReplyRequest
+	(*UpdateFeedbackReplyResponse)(nil),        // 29: customer.UpdateFeedbackReplyResponse
+	(*DeleteFeedbackReplyRequest)(nil),         // 30: customer.DeleteFeedbackReplyRequest
+	(*DeleteFeedbackReplyResponse)(nil),        // 31: customer.DeleteFeedbackReplyResponse
+	(*ListFeedbacksForModerationRequest)(nil),  // 32: customer.ListFeedbacksForModerationRequest
+	(*ListFeedbacksForModerationResponse)(nil), // 33: customer.ListFeedbacksForModerationResponse
+	(*ModerateFeedbackRequest)(nil),            // 34: customer.ModerateFeedbackRequest
+	(*ModerateFeedbackResponse)(nil),           // 35: customer.ModerateFeedbackResponse
+	(*CustomerRating)(nil),                     // 36: customer.CustomerRating
+	(*CriterionRating)(nil),                    // 37: customer.CriterionRating
+	(*GetCustomerRatingRequest)(nil),           // 38: customer.GetCustomerRatingRequest
+	(*GetCustomerRatingResponse)(nil),          // 39: customer.GetCustomerRatingResponse
+	(*VolunteerFeedback)(nil),                  // 40: customer.VolunteerFeedback
+	(*CreateVolunteerFeedbackRequest)(nil),     // 41: customer.CreateVolunteerFeedbackRequest
+	(*CreateVolunteerFeedbackResponse)(nil),    // 42: customer.CreateVolunteerFeedbackResponse
+	(*GetVolunteerFeedbacksRequest)(nil),       // 43: customer.GetVolunteerFeedbacksRequest
+	(*GetVolunteerFeedbacksResponse)(nil),      // 44: customer.GetVolunteerFeedbacksResponse
+	(*GetVolunteerFeedbackByIDRequest)(nil),    // 45: customer.GetVolunteerFeedbackByIDRequest
+	(*GetVolunteerFeedbackByIDResponse)(nil),   // 46: customer.GetVolunteerFeedbackByIDResponse
+	(*VolunteerRating)(nil),                    // 47: customer.VolunteerRating
+	(*GetVolunteerRatingRequest)(nil),          // 48: customer.GetVolunteerRatingRequest
+	(*GetVolunteerRatingResponse)(nil),         // 49: customer.GetVolunteerRatingResponse
+	(*Customer)(nil),                           // 50: customer.Customer
+	(*ScreeningVerdict)(nil),                   // 51: customer.ScreeningVerdict
+	(*CreateCustomerRequest)(nil),              // 52: customer.CreateCustomerRequest
+	(*GetCustomersRequest)(nil),                // 53: customer.GetCustomersRequest
+	(*GetCustomersResponse)(nil),               // 54: customer.GetCustomersResponse
+	(*SearchCustomersRequest)(nil),             // 55: customer.SearchCustomersRequest
+	(*CustomerSearchResult)(nil),               // 56: customer.CustomerSearchResult
+	(*SearchCustomersResponse)(nil),            // 57: customer.SearchCustomersResponse
+	(*GetCustomerByMaxIDRequest)(nil),          // 58: customer.GetCustomerByMaxIDRequest
+	(*GetCustomerByMaxIDResponse)(nil),         // 59: customer.GetCustomerByMaxIDResponse
+	(*UpdateCustomerRequest)(nil),              // 60: customer.UpdateCustomerRequest
+	(*UpdateCustomerResponse)(nil),             // 61: customer.UpdateCustomerResponse
+	(*DeleteCustomerRequest)(nil),              // 62: customer.DeleteCustomerRequest
+	(*DeleteCustomerResponse)(nil),             // 63: customer.DeleteCustomerResponse
+	(*RestoreCustomerRequest)(nil),             // 64: customer.RestoreCustomerRequest
+	(*RestoreCustomerResponse)(nil),            // 65: customer.RestoreCustomerResponse
+	(*PurgeCustomerRequest)(nil),               // 66: customer.PurgeCustomerRequest
+	(*PurgeCustomerResponse)(nil),              // 67: customer.PurgeCustomerResponse
+	(*CreateCustomerResponse)(nil),             // 68: customer.CreateCustomerResponse
+	(*Report)(nil),                             // 69: customer.Report
+	(*ReportCustomerRequest)(nil),              // 70: customer.ReportCustomerRequest
+	(*ReportCustomerResponse)(nil),             // 71: customer.ReportCustomerResponse
+	(*ReportFeedbackRequest)(nil),              // 72: customer.ReportFeedbackRequest
+	(*ReportFeedbackResponse)(nil),             // 73: customer.ReportFeedbackResponse
+	(*ListReportsRequest)(nil),                 // 74: customer.ListReportsRequest
+	(*ListReportsResponse)(nil),                // 75: customer.ListReportsResponse
+	(*ResolveReportsRequest)(nil),              // 76: customer.ResolveReportsRequest
+	(*ResolveReportsResponse)(nil),             // 77: customer.ResolveReportsResponse
+	(*Error)(nil),                              // 78: customer.Error
+	(*FieldViolation)(nil),                     // 79: customer.FieldViolation
+	nil,                                        // 80: customer.CustomerRating.DistributionEntry
+	nil,                                        // 81: customer.VolunteerRating.DistributionEntry
+	(*fieldmaskpb.FieldMask)(nil),              // 82: google.protobuf.FieldMask
 }
 var file_proto_customer_customer_proto_depIdxs = []int32{
-	10, // 0: customer.GetFeedbackByIDResponse.Feedback:type_name -> customer.Feedback
-	22, // 1: customer.GetFeedbackByIDResponse.error:type_name -> customer.Error
-	10, // 2: customer.CreateFeedbackRequest.Feedback:type_name -> customer.Feedback
-	10, // 3: customer.CreateFeedbackResponse.Feedback:type_name -> customer.Feedback
-	22, // 4: customer.CreateFeedbackResponse.error:type_name -> customer.Error
-	10, // 5: customer.GetFeedbacksResponse.Feedbacks:type_name -> customer.Feedback
-	22, // 6: customer.GetFeedbacksResponse.error:type_name -> customer.Error
-	22, // 7: customer.CountFeedbacksResponse.error:type_name -> customer.Error
-	0,  // 8: customer.Customer.type:type_name -> customer.CustomerType
-	11, // 9: customer.CreateCustomerRequest.Customer:type_name -> customer.Customer
-	11, // 10: customer.GetCustomersResponse.Customers:type_name -> customer.Customer
-	22, // 11: customer.GetCustomersResponse.error:type_name -> customer.Error
-	11, // 12: customer.GetCustomerByMaxIDResponse.Customer:type_name -> customer.Customer
-	22, // 13: customer.GetCustomerByMaxIDResponse.error:type_name -> customer.Error
-	11, // 14: customer.UpdateCustomerRequest.Customer:type_name -> customer.Customer
-	11, // 15: customer.UpdateCustomerResponse.Customer:type_name -> customer.Customer
-	22, // 16: customer.UpdateCustomerResponse.error:type_name -> customer.Error
-	22, // 17: customer.DeleteCustomerResponse.error:type_name -> customer.Error
-	11, // 18: customer.CreateCustomerResponse.Customer:type_name -> customer.Customer
-	22, // 19: customer.CreateCustomerResponse.error:type_name -> customer.Error
-	1,  // 20: customer.Error.code:type_name -> customer.ErrorCode
-	12, // 21: customer.CustomerService.CreateCustomer:input_type -> customer.CreateCustomerRequest
-	13, // 22: customer.CustomerService.GetCustomers:input_type -> customer.GetCustomersRequest
-	15, // 23: customer.CustomerService.GetCustomerByMaxID:input_type -> customer.GetCustomerByMaxIDRequest
-	17, // 24: customer.CustomerService.UpdateCustomer:input_type -> customer.UpdateCustomerRequest
-	19, // 25: customer.CustomerService.DeleteCustomer:input_type -> customer.DeleteCustomerRequest
-	4,  // 26: customer.CustomerService.CreateFeedback:input_type -> customer.CreateFeedbackRequest
-	6,  // 27: customer.CustomerService.GetFeedbacks:input_type -> customer.GetFeedbacksRequest
-	8,  // 28: customer.CustomerService.CountFeedbacks:input_type -> customer.CountFeedbacksRequest
-	2,  // 29: customer.CustomerService.GetFeedbackByID:input_type -> customer.GetFeedbackByIDRequest
-	21, // 30: customer.CustomerService.CreateCustomer:output_type -> customer.CreateCustomerResponse
-	14, // 31: customer.CustomerService.GetCustomers:output_type -> customer.GetCustomersResponse
-	16, // 32: customer.CustomerService.GetCustomerByMaxID:output_type -> customer.GetCustomerByMaxIDResponse
-	18, // 33: customer.CustomerService.UpdateCustomer:output_type -> customer.UpdateCustomerResponse
-	20, // 34: customer.CustomerService.DeleteCustomer:output_type -> customer.DeleteCustomerResponse
-	5,  // 35: customer.CustomerService.CreateFeedback:output_type -> customer.CreateFeedbackResponse
-	7,  // 36: customer.CustomerService.GetFeedbacks:output_type -> customer.GetFeedbacksResponse
-	9,  // 37: customer.CustomerService.CountFeedbacks:output_type -> customer.CountFeedbacksResponse
-	3,  // 38: customer.CustomerService.GetFeedbackByID:output_type -> customer.GetFeedbackByIDResponse
-	30, // [30:39] is the sub-list for method output_type
-	21, // [21:30] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	22,  // 0: customer.GetFeedbackByIDResponse.Feedback:type_name -> customer.Feedback
+	78,  // 1: customer.GetFeedbackByIDResponse.error:type_name -> customer.Error
+	22,  // 2: customer.CreateFeedbackRequest.Feedback:type_name -> customer.Feedback
+	22,  // 3: customer.CreateFeedbackResponse.Feedback:type_name -> customer.Feedback
+	78,  // 4: customer.CreateFeedbackResponse.error:type_name -> customer.Error
+	24,  // 5: customer.UpdateFeedbackRequest.scores:type_name -> customer.CriterionScore
+	22,  // 6: customer.UpdateFeedbackResponse.Feedback:type_name -> customer.Feedback
+	78,  // 7: customer.UpdateFeedbackResponse.error:type_name -> customer.Error
+	78,  // 8: customer.DeleteFeedbackResponse.error:type_name -> customer.Error
+	22,  // 9: customer.GetFeedbacksResponse.Feedbacks:type_name -> customer.Feedback
+	78,  // 10: customer.GetFeedbacksResponse.error:type_name -> customer.Error
+	78,  // 11: customer.CountFeedbacksResponse.error:type_name -> customer.Error
+	0,   // 12: customer.Feedback.status:type_name -> customer.FeedbackStatus
+	51,  // 13: customer.Feedback.screening:type_name -> customer.ScreeningVerdict
+	25,  // 14: customer.Feedback.reply:type_name -> customer.FeedbackReply
+	24,  // 15: customer.Feedback.scores:type_name -> customer.CriterionScore
+	23,  // 16: customer.Feedback.abuse:type_name -> customer.AbuseAssessment
+	51,  // 17: customer.FeedbackReply.screening:type_name -> customer.ScreeningVerdict
+	25,  // 18: customer.ReplyToFeedbackResponse.reply:type_name -> customer.FeedbackReply
+	78,  // 19: customer.ReplyToFeedbackResponse.error:type_name -> customer.Error
+	25,  // 20: customer.UpdateFeedbackReplyResponse.reply:type_name -> customer.FeedbackReply
+	78,  // 21: customer.UpdateFeedbackReplyResponse.error:type_name -> customer.Error
+	78,  // 22: customer.DeleteFeedbackReplyResponse.error:type_name -> customer.Error
+	0,   // 23: customer.ListFeedbacksForModerationRequest.status:type_name -> customer.FeedbackStatus
+	22,  // 24: customer.ListFeedbacksForModerationResponse.Feedbacks:type_name -> customer.Feedback
+	78,  // 25: customer.ListFeedbacksForModerationResponse.error:type_name -> customer.Error
+	0,   // 26: customer.ModerateFeedbackRequest.decision:type_name -> customer.FeedbackStatus
+	22,  // 27: customer.ModerateFeedbackResponse.Feedback:type_name -> customer.Feedback
+	78,  // 28: customer.ModerateFeedbackResponse.error:type_name -> customer.Error
+	80,  // 29: customer.CustomerRating.distribution:type_name -> customer.CustomerRating.DistributionEntry
+	37,  // 30: customer.CustomerRating.criteria:type_name -> customer.CriterionRating
+	36,  // 31: customer.GetCustomerRatingResponse.rating:type_name -> customer.CustomerRating
+	78,  // 32: customer.GetCustomerRatingResponse.error:type_name -> customer.Error
+	51,  // 33: customer.VolunteerFeedback.screening:type_name -> customer.ScreeningVerdict
+	40,  // 34: customer.CreateVolunteerFeedbackRequest.Feedback:type_name -> customer.VolunteerFeedback
+	40,  // 35: customer.CreateVolunteerFeedbackResponse.Feedback:type_name -> customer.VolunteerFeedback
+	78,  // 36: customer.CreateVolunteerFeedbackResponse.error:type_name -> customer.Error
+	40,  // 37: customer.GetVolunteerFeedbacksResponse.Feedbacks:type_name -> customer.VolunteerFeedback
+	78,  // 38: customer.GetVolunteerFeedbacksResponse.error:type_name -> customer.Error
+	40,  // 39: customer.GetVolunteerFeedbackByIDResponse.Feedback:type_name -> customer.VolunteerFeedback
+	78,  // 40: customer.GetVolunteerFeedbackByIDResponse.error:type_name -> customer.Error
+	81,  // 41: customer.VolunteerRating.distribution:type_name -> customer.VolunteerRating.DistributionEntry
+	47,  // 42: customer.GetVolunteerRatingResponse.rating:type_name -> customer.VolunteerRating
+	78,  // 43: customer.GetVolunteerRatingResponse.error:type_name -> customer.Error
+	3,   // 44: customer.Customer.type:type_name -> customer.CustomerType
+	51,  // 45: customer.Customer.screening:type_name -> customer.ScreeningVerdict
+	1,   // 46: customer.ScreeningVerdict.action:type_name -> customer.ScreeningAction
+	2,   // 47: customer.ScreeningVerdict.categories:type_name -> customer.ScreeningCategory
+	50,  // 48: customer.CreateCustomerRequest.Customer:type_name -> customer.Customer
+	4,   // 49: customer.GetCustomersRequest.sort_by:type_name -> customer.CustomerSortField
+	3,   // 50: customer.GetCustomersRequest.type:type_name -> customer.CustomerType
+	5,   // 51: customer.GetCustomersRequest.sort_direction:type_name -> customer.SortDirection
+	50,  // 52: customer.GetCustomersResponse.Customers:type_name -> customer.Customer
+	78,  // 53: customer.GetCustomersResponse.error:type_name -> customer.Error
+	3,   // 54: customer.SearchCustomersRequest.type:type_name -> customer.CustomerType
+	50,  // 55: customer.CustomerSearchResult.Customer:type_name -> customer.Customer
+	56,  // 56: customer.SearchCustomersResponse.results:type_name -> customer.CustomerSearchResult
+	78,  // 57: customer.SearchCustomersResponse.error:type_name -> customer.Error
+	50,  // 58: customer.GetCustomerByMaxIDResponse.Customer:type_name -> customer.Customer
+	78,  // 59: customer.GetCustomerByMaxIDResponse.error:type_name -> customer.Error
+	50,  // 60: customer.UpdateCustomerRequest.Customer:type_name -> customer.Customer
+	82,  // 61: customer.UpdateCustomerRequest.update_mask:type_name -> google.protobuf.FieldMask
+	50,  // 62: customer.UpdateCustomerResponse.Customer:type_name -> customer.Customer
+	78,  // 63: customer.UpdateCustomerResponse.error:type_name -> customer.Error
+	78,  // 64: customer.DeleteCustomerResponse.error:type_name -> customer.Error
+	50,  // 65: customer.RestoreCustomerResponse.Customer:type_name -> customer.Customer
+	78,  // 66: customer.RestoreCustomerResponse.error:type_name -> customer.Error
+	78,  // 67: customer.PurgeCustomerResponse.error:type_name -> customer.Error
+	50,  // 68: customer.CreateCustomerResponse.Customer:type_name -> customer.Customer
+	78,  // 69: customer.CreateCustomerResponse.error:type_name -> customer.Error
+	6,   // 70: customer.Report.target:type_name -> customer.ReportTarget
+	7,   // 71: customer.Report.reason:type_name -> customer.ReportReason
+	8,   // 72: customer.Report.status:type_name -> customer.ReportStatus
+	7,   // 73: customer.ReportCustomerRequest.reason:type_name -> customer.ReportReason
+	69,  // 74: customer.ReportCustomerResponse.report:type_name -> customer.Report
+	78,  // 75: customer.ReportCustomerResponse.error:type_name -> customer.Error
+	7,   // 76: customer.ReportFeedbackRequest.reason:type_name -> customer.ReportReason
+	69,  // 77: customer.ReportFeedbackResponse.report:type_name -> customer.Report
+	78,  // 78: customer.ReportFeedbackResponse.error:type_name -> customer.Error
+	6,   // 79: customer.ListReportsRequest.target:type_name -> customer.ReportTarget
+	8,   // 80: customer.ListReportsRequest.status:type_name -> customer.ReportStatus
+	7,   // 81: customer.ListReportsRequest.reason:type_name -> customer.ReportReason
+	69,  // 82: customer.ListReportsResponse.reports:type_name -> customer.Report
+	78,  // 83: customer.ListReportsResponse.error:type_name -> customer.Error
+	6,   // 84: customer.ResolveReportsRequest.target:type_name -> customer.ReportTarget
+	8,   // 85: customer.ResolveReportsRequest.decision:type_name -> customer.ReportStatus
+	69,  // 86: customer.ResolveReportsResponse.reports:type_name -> customer.Report
+	78,  // 87: customer.ResolveReportsResponse.error:type_name -> customer.Error
+	9,   // 88: customer.Error.code:type_name -> customer.ErrorCode
+	79,  // 89: customer.Error.violations:type_name -> customer.FieldViolation
+	52,  // 90: customer.CustomerService.CreateCustomer:input_type -> customer.CreateCustomerRequest
+	53,  // 91: customer.CustomerService.GetCustomers:input_type -> customer.GetCustomersRequest
+	58,  // 92: customer.CustomerService.GetCustomerByMaxID:input_type -> customer.GetCustomerByMaxIDRequest
+	55,  // 93: customer.CustomerService.SearchCustomers:input_type -> customer.SearchCustomersRequest
+	60,  // 94: customer.CustomerService.UpdateCustomer:input_type -> customer.UpdateCustomerRequest
+	62,  // 95: customer.CustomerService.DeleteCustomer:input_type -> customer.DeleteCustomerRequest
+	64,  // 96: customer.CustomerService.RestoreCustomer:input_type -> customer.RestoreCustomerRequest
+	66,  // 97: customer.CustomerService.PurgeCustomer:input_type -> customer.PurgeCustomerRequest
+	12,  // 98: customer.CustomerService.CreateFeedback:input_type -> customer.CreateFeedbackRequest
+	18,  // 99: customer.CustomerService.GetFeedbacks:input_type -> customer.GetFeedbacksRequest
+	20,  // 100: customer.CustomerService.CountFeedbacks:input_type -> customer.CountFeedbacksRequest
+	10,  // 101: customer.CustomerService.GetFeedbackByID:input_type -> customer.GetFeedbackByIDRequest
+	14,  // 102: customer.CustomerService.UpdateFeedback:input_type -> customer.UpdateFeedbackRequest
+	16,  // 103: customer.CustomerService.DeleteFeedback:input_type -> customer.DeleteFeedbackRequest
+	26,  // 104: customer.CustomerService.ReplyToFeedback:input_type -> customer.ReplyToFeedbackRequest
+	28,  // 105: customer.CustomerService.UpdateFeedbackReply:input_type -> customer.UpdateFeedbackReplyRequest
+	30,  // 106: customer.CustomerService.DeleteFeedbackReply:input_type -> customer.DeleteFeedbackReplyRequest
+	32,  // 107: customer.CustomerService.ListFeedbacksForModeration:input_type -> customer.ListFeedbacksForModerationRequest
+	34,  // 108: customer.CustomerService.ModerateFeedback:input_type -> customer.ModerateFeedbackRequest
+	38,  // 109: customer.CustomerService.GetCustomerRating:input_type -> customer.GetCustomerRatingRequest
+	41,  // 110: customer.CustomerService.CreateVolunteerFeedback:input_type -> customer.CreateVolunteerFeedbackRequest
+	43,  // 111: customer.CustomerService.GetVolunteerFeedbacks:input_type -> customer.GetVolunteerFeedbacksRequest
+	45,  // 112: customer.CustomerService.GetVolunteerFeedbackByID:input_type -> customer.GetVolunteerFeedbackByIDRequest
+	48,  // 113: customer.CustomerService.GetVolunteerRating:input_type -> customer.GetVolunteerRatingRequest
+	70,  // 114: customer.CustomerService.ReportCustomer:input_type -> customer.ReportCustomerRequest
+	72,  // 115: customer.CustomerService.ReportFeedback:input_type -> customer.ReportFeedbackRequest
+	74,  // 116: customer.CustomerService.ListReports:input_type -> customer.ListReportsRequest
+	76,  // 117: customer.CustomerService.ResolveReports:input_type -> customer.ResolveReportsRequest
+	68,  // 118: customer.CustomerService.CreateCustomer:output_type -> customer.CreateCustomerResponse
+	54,  // 119: customer.CustomerService.GetCustomers:output_type -> customer.GetCustomersResponse
+	59,  // 120: customer.CustomerService.GetCustomerByMaxID:output_type -> customer.GetCustomerByMaxIDResponse
+	57,  // 121: customer.CustomerService.SearchCustomers:output_type -> customer.SearchCustomersResponse
+	61,  // 122: customer.CustomerService.UpdateCustomer:output_type -> customer.UpdateCustomerResponse
+	63,  // 123: customer.CustomerService.DeleteCustomer:output_type -> customer.DeleteCustomerResponse
+	65,  // 124: customer.CustomerService.RestoreCustomer:output_type -> customer.RestoreCustomerResponse
+	67,  // 125: customer.CustomerService.PurgeCustomer:output_type -> customer.PurgeCustomerResponse
+	13,  // 126: customer.CustomerService.CreateFeedback:output_type -> customer.CreateFeedbackResponse
+	19,  // 127: customer.CustomerService.GetFeedbacks:output_type -> customer.GetFeedbacksResponse
+	21,  // 128: customer.CustomerService.CountFeedbacks:output_type -> customer.CountFeedbacksResponse
+	11,  // 129: customer.CustomerService.GetFeedbackByID:output_type -> customer.GetFeedbackByIDResponse
+	15,  // 130: customer.CustomerService.UpdateFeedback:output_type -> customer.UpdateFeedbackResponse
+	17,  // 131: customer.CustomerService.DeleteFeedback:output_type -> customer.DeleteFeedbackResponse
+	27,  // 132: customer.CustomerService.ReplyToFeedback:output_type -> customer.ReplyToFeedbackResponse
+	29,  // 133: customer.CustomerService.UpdateFeedbackReply:output_type -> customer.UpdateFeedbackReplyResponse
+	31,  // 134: customer.CustomerService.DeleteFeedbackReply:output_type -> customer.DeleteFeedbackReplyResponse
+	33,  // 135: customer.CustomerService.ListFeedbacksForModeration:output_type -> customer.ListFeedbacksForModerationResponse
+	35,  // 136: customer.CustomerService.ModerateFeedback:output_type -> customer.ModerateFeedbackResponse
+	39,  // 137: customer.CustomerService.GetCustomerRating:output_type -> customer.GetCustomerRatingResponse
+	42,  // 138: customer.CustomerService.CreateVolunteerFeedback:output_type -> customer.CreateVolunteerFeedbackResponse
+	44,  // 139: customer.CustomerService.GetVolunteerFeedbacks:output_type -> customer.GetVolunteerFeedbacksResponse
+	46,  // 140: customer.CustomerService.GetVolunteerFeedbackByID:output_type -> customer.GetVolunteerFeedbackByIDResponse
+	49,  // 141: customer.CustomerService.GetVolunteerRating:output_type -> customer.GetVolunteerRatingResponse
+	71,  // 142: customer.CustomerService.ReportCustomer:output_type -> customer.ReportCustomerResponse
+	73,  // 143: customer.CustomerService.ReportFeedback:output_type -> customer.ReportFeedbackResponse
+	75,  // 144: customer.CustomerService.ListReports:output_type -> customer.ListReportsResponse
+	77,  // 145: customer.CustomerService.ResolveReports:output_type -> customer.ResolveReportsResponse
+	118, // [118:146] is the sub-list for method output_type
+	90,  // [90:118] is the sub-list for method input_type
+	90,  // [90:90] is the sub-list for extension type_name
+	90,  // [90:90] is the sub-list for extension extendee
+	0,   // [0:90] is the sub-list for field type_name
 }
 
 func init() { file_proto_customer_customer_proto_init() }
@@ -1492,8 +5834,8 @@ func file_proto_customer_customer_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_customer_customer_proto_rawDesc), len(file_proto_customer_customer_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   21,
+			NumEnums:      10,
+			NumMessages:   72,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,15 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CustomerService_CreateCustomer_FullMethodName     = "/customer.CustomerService/CreateCustomer"
-	CustomerService_GetCustomers_FullMethodName       = "/customer.CustomerService/GetCustomers"
-	CustomerService_GetCustomerByMaxID_FullMethodName = "/customer.CustomerService/GetCustomerByMaxID"
-	CustomerService_UpdateCustomer_FullMethodName     = "/customer.CustomerService/UpdateCustomer"
-	CustomerService_DeleteCustomer_FullMethodName     = "/customer.CustomerService/DeleteCustomer"
-	CustomerService_CreateFeedback_FullMethodName     = "/customer.CustomerService/CreateFeedback"
-	CustomerService_GetFeedbacks_FullMethodName       = "/customer.CustomerService/GetFeedbacks"
-	CustomerService_CountFeedbacks_FullMethodName     = "/customer.CustomerService/CountFeedbacks"
-	CustomerService_GetFeedbackByID_FullMethodName    = "/customer.CustomerService/GetFeedbackByID"
+	CustomerService_CreateCustomer_FullMethodName             = "/customer.CustomerService/CreateCustomer"
+	CustomerService_GetCustomers_FullMethodName               = "/customer.CustomerService/GetCustomers"
+	CustomerService_GetCustomerByMaxID_FullMethodName         = "/customer.CustomerService/GetCustomerByMaxID"
+	CustomerService_SearchCustomers_FullMethodName            = "/customer.CustomerService/SearchCustomers"
+	CustomerService_UpdateCustomer_FullMethodName             = "/customer.CustomerService/UpdateCustomer"
+	CustomerService_DeleteCustomer_FullMethodName             = "/customer.CustomerService/DeleteCustomer"
+	CustomerService_RestoreCustomer_FullMethodName            = "/customer.CustomerService/RestoreCustomer"
+	CustomerService_PurgeCustomer_FullMethodName              = "/customer.CustomerService/PurgeCustomer"
+	CustomerService_CreateFeedback_FullMethodName             = "/customer.CustomerService/CreateFeedback"
+	CustomerService_GetFeedbacks_FullMethodName               = "/customer.CustomerService/GetFeedbacks"
+	CustomerService_CountFeedbacks_FullMethodName             = "/customer.CustomerService/CountFeedbacks"
+	CustomerService_GetFeedbackByID_FullMethodName            = "/customer.CustomerService/GetFeedbackByID"
+	CustomerService_UpdateFeedback_FullMethodName             = "/customer.CustomerService/UpdateFeedback"
+	CustomerService_DeleteFeedback_FullMethodName             = "/customer.CustomerService/DeleteFeedback"
+	CustomerService_ReplyToFeedback_FullMethodName            = "/customer.CustomerService/ReplyToFeedback"
+	CustomerService_UpdateFeedbackReply_FullMethodName        = "/customer.CustomerService/UpdateFeedbackReply"
+	CustomerService_DeleteFeedbackReply_FullMethodName        = "/customer.CustomerService/DeleteFeedbackReply"
+	CustomerService_ListFeedbacksForModeration_FullMethodName = "/customer.CustomerService/ListFeedbacksForModeration"
+	CustomerService_ModerateFeedback_FullMethodName           = "/customer.CustomerService/ModerateFeedback"
+	CustomerService_GetCustomerRating_FullMethodName          = "/customer.CustomerService/GetCustomerRating"
+	CustomerService_CreateVolunteerFeedback_FullMethodName    = "/customer.CustomerService/CreateVolunteerFeedback"
+	CustomerService_GetVolunteerFeedbacks_FullMethodName      = "/customer.CustomerService/GetVolunteerFeedbacks"
+	CustomerService_GetVolunteerFeedbackByID_FullMethodName   = "/customer.CustomerService/GetVolunteerFeedbackByID"
+	CustomerService_GetVolunteerRating_FullMethodName         = "/customer.CustomerService/GetVolunteerRating"
+	CustomerService_ReportCustomer_FullMethodName             = "/customer.CustomerService/ReportCustomer"
+	CustomerService_ReportFeedback_FullMethodName             = "/customer.CustomerService/ReportFeedback"
+	CustomerService_ListReports_FullMethodName                = "/customer.CustomerService/ListReports"
+	CustomerService_ResolveReports_FullMethodName             = "/customer.CustomerService/ResolveReports"
 )
 
 // CustomerServiceClient is the client API for CustomerService service.
@@ -37,12 +56,36 @@ type CustomerServiceClient interface {
 	CreateCustomer(ctx context.Context, in *CreateCustomerRequest, opts ...grpc.CallOption) (*CreateCustomerResponse, error)
 	GetCustomers(ctx context.Context, in *GetCustomersRequest, opts ...grpc.CallOption) (*GetCustomersResponse, error)
 	GetCustomerByMaxID(ctx context.Context, in *GetCustomerByMaxIDRequest, opts ...grpc.CallOption) (*GetCustomerByMaxIDResponse, error)
+	SearchCustomers(ctx context.Context, in *SearchCustomersRequest, opts ...grpc.CallOption) (*SearchCustomersResponse, error)
 	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error)
 	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error)
+	RestoreCustomer(ctx context.Context, in *RestoreCustomerRequest, opts ...grpc.CallOption) (*RestoreCustomerResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	PurgeCustomer(ctx context.Context, in *PurgeCustomerRequest, opts ...grpc.CallOption) (*PurgeCustomerResponse, error)
 	CreateFeedback(ctx context.Context, in *CreateFeedbackRequest, opts ...grpc.CallOption) (*CreateFeedbackResponse, error)
 	GetFeedbacks(ctx context.Context, in *GetFeedbacksRequest, opts ...grpc.CallOption) (*GetFeedbacksResponse, error)
 	CountFeedbacks(ctx context.Context, in *CountFeedbacksRequest, opts ...grpc.CallOption) (*CountFeedbacksResponse, error)
 	GetFeedbackByID(ctx context.Context, in *GetFeedbackByIDRequest, opts ...grpc.CallOption) (*GetFeedbackByIDResponse, error)
+	UpdateFeedback(ctx context.Context, in *UpdateFeedbackRequest, opts ...grpc.CallOption) (*UpdateFeedbackResponse, error)
+	DeleteFeedback(ctx context.Context, in *DeleteFeedbackRequest, opts ...grpc.CallOption) (*DeleteFeedbackResponse, error)
+	ReplyToFeedback(ctx context.Context, in *ReplyToFeedbackRequest, opts ...grpc.CallOption) (*ReplyToFeedbackResponse, error)
+	UpdateFeedbackReply(ctx context.Context, in *UpdateFeedbackReplyRequest, opts ...grpc.CallOption) (*UpdateFeedbackReplyResponse, error)
+	DeleteFeedbackReply(ctx context.Context, in *DeleteFeedbackReplyRequest, opts ...grpc.CallOption) (*DeleteFeedbackReplyResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ListFeedbacksForModeration(ctx context.Context, in *ListFeedbacksForModerationRequest, opts ...grpc.CallOption) (*ListFeedbacksForModerationResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ModerateFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*ModerateFeedbackResponse, error)
+	GetCustomerRating(ctx context.Context, in *GetCustomerRatingRequest, opts ...grpc.CallOption) (*GetCustomerRatingResponse, error)
+	CreateVolunteerFeedback(ctx context.Context, in *CreateVolunteerFeedbackRequest, opts ...grpc.CallOption) (*CreateVolunteerFeedbackResponse, error)
+	GetVolunteerFeedbacks(ctx context.Context, in *GetVolunteerFeedbacksRequest, opts ...grpc.CallOption) (*GetVolunteerFeedbacksResponse, error)
+	GetVolunteerFeedbackByID(ctx context.Context, in *GetVolunteerFeedbackByIDRequest, opts ...grpc.CallOption) (*GetVolunteerFeedbackByIDResponse, error)
+	GetVolunteerRating(ctx context.Context, in *GetVolunteerRatingRequest, opts ...grpc.CallOption) (*GetVolunteerRatingResponse, error)
+	ReportCustomer(ctx context.Context, in *ReportCustomerRequest, opts ...grpc.CallOption) (*ReportCustomerResponse, error)
+	ReportFeedback(ctx context.Context, in *ReportFeedbackRequest, opts ...grpc.CallOption) (*ReportFeedbackResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error)
 }
 
 type customerServiceClient struct {
@@ -83,6 +126,16 @@ func (c *customerServiceClient) GetCustomerByMaxID(ctx context.Context, in *GetC
 	return out, nil
 }
 
+func (c *customerServiceClient) SearchCustomers(ctx context.Context, in *SearchCustomersRequest, opts ...grpc.CallOption) (*SearchCustomersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchCustomersResponse)
+	err := c.cc.Invoke(ctx, CustomerService_SearchCustomers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *customerServiceClient) UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*UpdateCustomerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateCustomerResponse)
@@ -103,6 +156,26 @@ func (c *customerServiceClient) DeleteCustomer(ctx context.Context, in *DeleteCu
 	return out, nil
 }
 
+func (c *customerServiceClient) RestoreCustomer(ctx context.Context, in *RestoreCustomerRequest, opts ...grpc.CallOption) (*RestoreCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_RestoreCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) PurgeCustomer(ctx context.Context, in *PurgeCustomerRequest, opts ...grpc.CallOption) (*PurgeCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_PurgeCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *customerServiceClient) CreateFeedback(ctx context.Context, in *CreateFeedbackRequest, opts ...grpc.CallOption) (*CreateFeedbackResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateFeedbackResponse)
@@ -143,6 +216,166 @@ func (c *customerServiceClient) GetFeedbackByID(ctx context.Context, in *GetFeed
 	return out, nil
 }
 
+func (c *customerServiceClient) UpdateFeedback(ctx context.Context, in *UpdateFeedbackRequest, opts ...grpc.CallOption) (*UpdateFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFeedbackResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteFeedback(ctx context.Context, in *DeleteFeedbackRequest, opts ...grpc.CallOption) (*DeleteFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeedbackResponse)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReplyToFeedback(ctx context.Context, in *ReplyToFeedbackRequest, opts ...grpc.CallOption) (*ReplyToFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplyToFeedbackResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ReplyToFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) UpdateFeedbackReply(ctx context.Context, in *UpdateFeedbackReplyRequest, opts ...grpc.CallOption) (*UpdateFeedbackReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateFeedbackReplyResponse)
+	err := c.cc.Invoke(ctx, CustomerService_UpdateFeedbackReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) DeleteFeedbackReply(ctx context.Context, in *DeleteFeedbackReplyRequest, opts ...grpc.CallOption) (*DeleteFeedbackReplyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteFeedbackReplyResponse)
+	err := c.cc.Invoke(ctx, CustomerService_DeleteFeedbackReply_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListFeedbacksForModeration(ctx context.Context, in *ListFeedbacksForModerationRequest, opts ...grpc.CallOption) (*ListFeedbacksForModerationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFeedbacksForModerationResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListFeedbacksForModeration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ModerateFeedback(ctx context.Context, in *ModerateFeedbackRequest, opts ...grpc.CallOption) (*ModerateFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateFeedbackResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ModerateFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetCustomerRating(ctx context.Context, in *GetCustomerRatingRequest, opts ...grpc.CallOption) (*GetCustomerRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCustomerRatingResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetCustomerRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) CreateVolunteerFeedback(ctx context.Context, in *CreateVolunteerFeedbackRequest, opts ...grpc.CallOption) (*CreateVolunteerFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVolunteerFeedbackResponse)
+	err := c.cc.Invoke(ctx, CustomerService_CreateVolunteerFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetVolunteerFeedbacks(ctx context.Context, in *GetVolunteerFeedbacksRequest, opts ...grpc.CallOption) (*GetVolunteerFeedbacksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVolunteerFeedbacksResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetVolunteerFeedbacks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetVolunteerFeedbackByID(ctx context.Context, in *GetVolunteerFeedbackByIDRequest, opts ...grpc.CallOption) (*GetVolunteerFeedbackByIDResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVolunteerFeedbackByIDResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetVolunteerFeedbackByID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) GetVolunteerRating(ctx context.Context, in *GetVolunteerRatingRequest, opts ...grpc.CallOption) (*GetVolunteerRatingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVolunteerRatingResponse)
+	err := c.cc.Invoke(ctx, CustomerService_GetVolunteerRating_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReportCustomer(ctx context.Context, in *ReportCustomerRequest, opts ...grpc.CallOption) (*ReportCustomerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportCustomerResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ReportCustomer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ReportFeedback(ctx context.Context, in *ReportFeedbackRequest, opts ...grpc.CallOption) (*ReportFeedbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportFeedbackResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ReportFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ListReports(ctx context.Context, in *ListReportsRequest, opts ...grpc.CallOption) (*ListReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReportsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ListReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *customerServiceClient) ResolveReports(ctx context.Context, in *ResolveReportsRequest, opts ...grpc.CallOption) (*ResolveReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveReportsResponse)
+	err := c.cc.Invoke(ctx, CustomerService_ResolveReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CustomerServiceServer is the server API for CustomerService service.
 // All implementations must embed UnimplementedCustomerServiceServer
 // for forward compatibility.
@@ -150,12 +383,36 @@ type CustomerServiceServer interface {
 	CreateCustomer(context.Context, *CreateCustomerRequest) (*CreateCustomerResponse, error)
 	GetCustomers(context.Context, *GetCustomersRequest) (*GetCustomersResponse, error)
 	GetCustomerByMaxID(context.Context, *GetCustomerByMaxIDRequest) (*GetCustomerByMaxIDResponse, error)
+	SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error)
 	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error)
 	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error)
+	RestoreCustomer(context.Context, *RestoreCustomerRequest) (*RestoreCustomerResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	PurgeCustomer(context.Context, *PurgeCustomerRequest) (*PurgeCustomerResponse, error)
 	CreateFeedback(context.Context, *CreateFeedbackRequest) (*CreateFeedbackResponse, error)
 	GetFeedbacks(context.Context, *GetFeedbacksRequest) (*GetFeedbacksResponse, error)
 	CountFeedbacks(context.Context, *CountFeedbacksRequest) (*CountFeedbacksResponse, error)
 	GetFeedbackByID(context.Context, *GetFeedbackByIDRequest) (*GetFeedbackByIDResponse, error)
+	UpdateFeedback(context.Context, *UpdateFeedbackRequest) (*UpdateFeedbackResponse, error)
+	DeleteFeedback(context.Context, *DeleteFeedbackRequest) (*DeleteFeedbackResponse, error)
+	ReplyToFeedback(context.Context, *ReplyToFeedbackRequest) (*ReplyToFeedbackResponse, error)
+	UpdateFeedbackReply(context.Context, *UpdateFeedbackReplyRequest) (*UpdateFeedbackReplyResponse, error)
+	DeleteFeedbackReply(context.Context, *DeleteFeedbackReplyRequest) (*DeleteFeedbackReplyResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ListFeedbacksForModeration(context.Context, *ListFeedbacksForModerationRequest) (*ListFeedbacksForModerationResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ModerateFeedback(context.Context, *ModerateFeedbackRequest) (*ModerateFeedbackResponse, error)
+	GetCustomerRating(context.Context, *GetCustomerRatingRequest) (*GetCustomerRatingResponse, error)
+	CreateVolunteerFeedback(context.Context, *CreateVolunteerFeedbackRequest) (*CreateVolunteerFeedbackResponse, error)
+	GetVolunteerFeedbacks(context.Context, *GetVolunteerFeedbacksRequest) (*GetVolunteerFeedbacksResponse, error)
+	GetVolunteerFeedbackByID(context.Context, *GetVolunteerFeedbackByIDRequest) (*GetVolunteerFeedbackByIDResponse, error)
+	GetVolunteerRating(context.Context, *GetVolunteerRatingRequest) (*GetVolunteerRatingResponse, error)
+	ReportCustomer(context.Context, *ReportCustomerRequest) (*ReportCustomerResponse, error)
+	ReportFeedback(context.Context, *ReportFeedbackRequest) (*ReportFeedbackResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error)
+	// Admin only, served over gRPC and never through the HTTP gateway.
+	ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error)
 	mustEmbedUnimplementedCustomerServiceServer()
 }
 
@@ -175,12 +432,21 @@ func (UnimplementedCustomerServiceServer) GetCustomers(context.Context, *GetCust
 func (UnimplementedCustomerServiceServer) GetCustomerByMaxID(context.Context, *GetCustomerByMaxIDRequest) (*GetCustomerByMaxIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerByMaxID not implemented")
 }
+func (UnimplementedCustomerServiceServer) SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCustomers not implemented")
+}
 func (UnimplementedCustomerServiceServer) UpdateCustomer(context.Context, *UpdateCustomerRequest) (*UpdateCustomerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCustomer not implemented")
 }
 func (UnimplementedCustomerServiceServer) DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
 }
+func (UnimplementedCustomerServiceServer) RestoreCustomer(context.Context, *RestoreCustomerRequest) (*RestoreCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) PurgeCustomer(context.Context, *PurgeCustomerRequest) (*PurgeCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCustomer not implemented")
+}
 func (UnimplementedCustomerServiceServer) CreateFeedback(context.Context, *CreateFeedbackRequest) (*CreateFeedbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFeedback not implemented")
 }
@@ -193,6 +459,54 @@ func (UnimplementedCustomerServiceServer) CountFeedbacks(context.Context, *Count
 func (UnimplementedCustomerServiceServer) GetFeedbackByID(context.Context, *GetFeedbackByIDRequest) (*GetFeedbackByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFeedbackByID not implemented")
 }
+func (UnimplementedCustomerServiceServer) UpdateFeedback(context.Context, *UpdateFeedbackRequest) (*UpdateFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeedback not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteFeedback(context.Context, *DeleteFeedbackRequest) (*DeleteFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeedback not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReplyToFeedback(context.Context, *ReplyToFeedbackRequest) (*ReplyToFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyToFeedback not implemented")
+}
+func (UnimplementedCustomerServiceServer) UpdateFeedbackReply(context.Context, *UpdateFeedbackReplyRequest) (*UpdateFeedbackReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeedbackReply not implemented")
+}
+func (UnimplementedCustomerServiceServer) DeleteFeedbackReply(context.Context, *DeleteFeedbackReplyRequest) (*DeleteFeedbackReplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeedbackReply not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListFeedbacksForModeration(context.Context, *ListFeedbacksForModerationRequest) (*ListFeedbacksForModerationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeedbacksForModeration not implemented")
+}
+func (UnimplementedCustomerServiceServer) ModerateFeedback(context.Context, *ModerateFeedbackRequest) (*ModerateFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateFeedback not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetCustomerRating(context.Context, *GetCustomerRatingRequest) (*GetCustomerRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerRating not implemented")
+}
+func (UnimplementedCustomerServiceServer) CreateVolunteerFeedback(context.Context, *CreateVolunteerFeedbackRequest) (*CreateVolunteerFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVolunteerFeedback not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetVolunteerFeedbacks(context.Context, *GetVolunteerFeedbacksRequest) (*GetVolunteerFeedbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolunteerFeedbacks not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetVolunteerFeedbackByID(context.Context, *GetVolunteerFeedbackByIDRequest) (*GetVolunteerFeedbackByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolunteerFeedbackByID not implemented")
+}
+func (UnimplementedCustomerServiceServer) GetVolunteerRating(context.Context, *GetVolunteerRatingRequest) (*GetVolunteerRatingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVolunteerRating not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReportCustomer(context.Context, *ReportCustomerRequest) (*ReportCustomerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportCustomer not implemented")
+}
+func (UnimplementedCustomerServiceServer) ReportFeedback(context.Context, *ReportFeedbackRequest) (*ReportFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportFeedback not implemented")
+}
+func (UnimplementedCustomerServiceServer) ListReports(context.Context, *ListReportsRequest) (*ListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedCustomerServiceServer) ResolveReports(context.Context, *ResolveReportsRequest) (*ResolveReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveReports not implemented")
+}
 func (UnimplementedCustomerServiceServer) mustEmbedUnimplementedCustomerServiceServer() {}
 func (UnimplementedCustomerServiceServer) testEmbeddedByValue()                         {}
 
@@ -268,6 +582,24 @@ func _CustomerService_GetCustomerByMaxID_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomerService_SearchCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).SearchCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_SearchCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).SearchCustomers(ctx, req.(*SearchCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CustomerService_UpdateCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateCustomerRequest)
 	if err := dec(in); err != nil {
@@ -304,6 +636,42 @@ func _CustomerService_DeleteCustomer_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomerService_RestoreCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).RestoreCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_RestoreCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).RestoreCustomer(ctx, req.(*RestoreCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_PurgeCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).PurgeCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_PurgeCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).PurgeCustomer(ctx, req.(*PurgeCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CustomerService_CreateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateFeedbackRequest)
 	if err := dec(in); err != nil {
@@ -376,6 +744,294 @@ func _CustomerService_GetFeedbackByID_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CustomerService_UpdateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateFeedback(ctx, req.(*UpdateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteFeedback(ctx, req.(*DeleteFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ReplyToFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyToFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReplyToFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ReplyToFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ReplyToFeedback(ctx, req.(*ReplyToFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_UpdateFeedbackReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFeedbackReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).UpdateFeedbackReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_UpdateFeedbackReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).UpdateFeedbackReply(ctx, req.(*UpdateFeedbackReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_DeleteFeedbackReply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeedbackReplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).DeleteFeedbackReply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_DeleteFeedbackReply_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).DeleteFeedbackReply(ctx, req.(*DeleteFeedbackReplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListFeedbacksForModeration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeedbacksForModerationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListFeedbacksForModeration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListFeedbacksForModeration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListFeedbacksForModeration(ctx, req.(*ListFeedbacksForModerationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ModerateFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ModerateFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ModerateFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ModerateFeedback(ctx, req.(*ModerateFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetCustomerRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomerRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetCustomerRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetCustomerRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetCustomerRating(ctx, req.(*GetCustomerRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_CreateVolunteerFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolunteerFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).CreateVolunteerFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_CreateVolunteerFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).CreateVolunteerFeedback(ctx, req.(*CreateVolunteerFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetVolunteerFeedbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolunteerFeedbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetVolunteerFeedbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetVolunteerFeedbacks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetVolunteerFeedbacks(ctx, req.(*GetVolunteerFeedbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetVolunteerFeedbackByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolunteerFeedbackByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetVolunteerFeedbackByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetVolunteerFeedbackByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetVolunteerFeedbackByID(ctx, req.(*GetVolunteerFeedbackByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_GetVolunteerRating_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolunteerRatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).GetVolunteerRating(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_GetVolunteerRating_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).GetVolunteerRating(ctx, req.(*GetVolunteerRatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ReportCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportCustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReportCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ReportCustomer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ReportCustomer(ctx, req.(*ReportCustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ReportFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ReportFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ReportFeedback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ReportFeedback(ctx, req.(*ReportFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ListReports(ctx, req.(*ListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CustomerService_ResolveReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CustomerServiceServer).ResolveReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CustomerService_ResolveReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CustomerServiceServer).ResolveReports(ctx, req.(*ResolveReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CustomerService_ServiceDesc is the grpc.ServiceDesc for CustomerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -395,6 +1051,10 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCustomerByMaxID",
 			Handler:    _CustomerService_GetCustomerByMaxID_Handler,
 		},
+		{
+			MethodName: "SearchCustomers",
+			Handler:    _CustomerService_SearchCustomers_Handler,
+		},
 		{
 			MethodName: "UpdateCustomer",
 			Handler:    _CustomerService_UpdateCustomer_Handler,
@@ -403,6 +1063,14 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCustomer",
 			Handler:    _CustomerService_DeleteCustomer_Handler,
 		},
+		{
+			MethodName: "RestoreCustomer",
+			Handler:    _CustomerService_RestoreCustomer_Handler,
+		},
+		{
+			MethodName: "PurgeCustomer",
+			Handler:    _CustomerService_PurgeCustomer_Handler,
+		},
 		{
 			MethodName: "CreateFeedback",
 			Handler:    _CustomerService_CreateFeedback_Handler,
@@ -419,6 +1087,70 @@ var CustomerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetFeedbackByID",
 			Handler:    _CustomerService_GetFeedbackByID_Handler,
 		},
+		{
+			MethodName: "UpdateFeedback",
+			Handler:    _CustomerService_UpdateFeedback_Handler,
+		},
+		{
+			MethodName: "DeleteFeedback",
+			Handler:    _CustomerService_DeleteFeedback_Handler,
+		},
+		{
+			MethodName: "ReplyToFeedback",
+			Handler:    _CustomerService_ReplyToFeedback_Handler,
+		},
+		{
+			MethodName: "UpdateFeedbackReply",
+			Handler:    _CustomerService_UpdateFeedbackReply_Handler,
+		},
+		{
+			MethodName: "DeleteFeedbackReply",
+			Handler:    _CustomerService_DeleteFeedbackReply_Handler,
+		},
+		{
+			MethodName: "ListFeedbacksForModeration",
+			Handler:    _CustomerService_ListFeedbacksForModeration_Handler,
+		},
+		{
+			MethodName: "ModerateFeedback",
+			Handler:    _CustomerService_ModerateFeedback_Handler,
+		},
+		{
+			MethodName: "GetCustomerRating",
+			Handler:    _CustomerService_GetCustomerRating_Handler,
+		},
+		{
+			MethodName: "CreateVolunteerFeedback",
+			Handler:    _CustomerService_CreateVolunteerFeedback_Handler,
+		},
+		{
+			MethodName: "GetVolunteerFeedbacks",
+			Handler:    _CustomerService_GetVolunteerFeedbacks_Handler,
+		},
+		{
+			MethodName: "GetVolunteerFeedbackByID",
+			Handler:    _CustomerService_GetVolunteerFeedbackByID_Handler,
+		},
+		{
+			MethodName: "GetVolunteerRating",
+			Handler:    _CustomerService_GetVolunteerRating_Handler,
+		},
+		{
+			MethodName: "ReportCustomer",
+			Handler:    _CustomerService_ReportCustomer_Handler,
+		},
+		{
+			MethodName: "ReportFeedback",
+			Handler:    _CustomerService_ReportFeedback_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _CustomerService_ListReports_Handler,
+		},
+		{
+			MethodName: "ResolveReports",
+			Handler:    _CustomerService_ResolveReports_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/customer/customer.proto",
@@ -0,0 +1,193 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v5.29.3
+// source: proto/task/task.proto
+
+package task
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type VerifyParticipationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,3,opt,name=customer_id,json=customerId,proto3" json:"customer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyParticipationRequest) Reset() {
+	*x = VerifyParticipationRequest{}
+	mi := &file_proto_task_task_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyParticipationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyParticipationRequest) ProtoMessage() {}
+
+func (x *VerifyParticipationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_task_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyParticipationRequest.ProtoReflect.Descriptor instead.
+func (*VerifyParticipationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_task_task_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *VerifyParticipationRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *VerifyParticipationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *VerifyParticipationRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
+}
+
+type VerifyParticipationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// True when the user took part in the task published by the customer.
+	Participated  bool `protobuf:"varint,1,opt,name=participated,proto3" json:"participated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyParticipationResponse) Reset() {
+	*x = VerifyParticipationResponse{}
+	mi := &file_proto_task_task_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyParticipationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyParticipationResponse) ProtoMessage() {}
+
+func (x *VerifyParticipationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_task_task_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyParticipationResponse.ProtoReflect.Descriptor instead.
+func (*VerifyParticipationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_task_task_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *VerifyParticipationResponse) GetParticipated() bool {
+	if x != nil {
+		return x.Participated
+	}
+	return false
+}
+
+var File_proto_task_task_proto protoreflect.FileDescriptor
+
+const file_proto_task_task_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/task/task.proto\x12\x04task\"o\n" +
+	"\x1aVerifyParticipationRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\tR\x06taskId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vcustomer_id\x18\x03 \x01(\tR\n" +
+	"customerId\"A\n" +
+	"\x1bVerifyParticipationResponse\x12\"\n" +
+	"\fparticipated\x18\x01 \x01(\bR\fparticipated2i\n" +
+	"\vTaskService\x12Z\n" +
+	"\x13VerifyParticipation\x12 .task.VerifyParticipationRequest\x1a!.task.VerifyParticipationResponseB;Z9DobrikaDev/customer-service/internal/generated/proto/taskb\x06proto3"
+
+var (
+	file_proto_task_task_proto_rawDescOnce sync.Once
+	file_proto_task_task_proto_rawDescData []byte
+)
+
+func file_proto_task_task_proto_rawDescGZIP() []byte {
+	file_proto_task_task_proto_rawDescOnce.Do(func() {
+		file_proto_task_task_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_task_task_proto_rawDesc), len(file_proto_task_task_proto_rawDesc)))
+	})
+	return file_proto_task_task_proto_rawDescData
+}
+
+var file_proto_task_task_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_task_task_proto_goTypes = []any{
+	(*VerifyParticipationRequest)(nil),  // 0: task.VerifyParticipationRequest
+	(*VerifyParticipationResponse)(nil), // 1: task.VerifyParticipationResponse
+}
+var file_proto_task_task_proto_depIdxs = []int32{
+	0, // 0: task.TaskService.VerifyParticipation:input_type -> task.VerifyParticipationRequest
+	1, // 1: task.TaskService.VerifyParticipation:output_type -> task.VerifyParticipationResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_task_task_proto_init() }
+func file_proto_task_task_proto_init() {
+	if File_proto_task_task_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_task_task_proto_rawDesc), len(file_proto_task_task_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_task_task_proto_goTypes,
+		DependencyIndexes: file_proto_task_task_proto_depIdxs,
+		MessageInfos:      file_proto_task_task_proto_msgTypes,
+	}.Build()
+	File_proto_task_task_proto = out.File
+	file_proto_task_task_proto_goTypes = nil
+	file_proto_task_task_proto_depIdxs = nil
+}
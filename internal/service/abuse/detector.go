@@ -0,0 +1,76 @@
+package abuse
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/utils/config"
+	"math"
+	"time"
+)
+
+// Weights of the score components. Coordinated attacks show up as velocity
+// and bursts first, so those weigh the most.
+const (
+	velocityWeight   = 0.3
+	burstWeight      = 0.3
+	accountAgeWeight = 0.2
+	deviationWeight  = 0.2
+)
+
+// Detector turns the signals gathered for a new feedback into an abuse score
+// between 0 and 1. The signals are collected by the caller.
+type Detector struct {
+	cfg config.Abuse
+}
+
+func NewDetector(cfg config.Abuse) *Detector {
+	return &Detector{cfg: cfg}
+}
+
+func (d *Detector) Enabled() bool {
+	return d.cfg.Enabled
+}
+
+// Window is how far back velocity and bursts are counted.
+func (d *Detector) Window() time.Duration {
+	return d.cfg.Window
+}
+
+func (d *Detector) Assess(signals domain.AbuseSignals) *domain.AbuseAssessment {
+	assessment := &domain.AbuseAssessment{
+		Velocity:   saturate(signals.UserFeedbacks, d.cfg.VelocityLimit),
+		Burst:      saturate(signals.CustomerFeedbacks, d.cfg.BurstLimit),
+		AccountAge: d.accountAge(signals.AccountAge, signals.UnknownAccount),
+		Deviation:  d.deviation(signals.Rating, signals.CustomerRating),
+	}
+	assessment.Score = velocityWeight*assessment.Velocity +
+		burstWeight*assessment.Burst +
+		accountAgeWeight*assessment.AccountAge +
+		deviationWeight*assessment.Deviation
+	assessment.Held = d.cfg.HoldThreshold > 0 && assessment.Score >= d.cfg.HoldThreshold
+	return assessment
+}
+
+// accountAge is 1 for a brand new account and falls to 0 at MinAccountAge.
+// An account of unknown age is neither trusted nor suspected and scores 0.
+func (d *Detector) accountAge(age time.Duration, unknown bool) float64 {
+	if unknown || d.cfg.MinAccountAge <= 0 || age >= d.cfg.MinAccountAge {
+		return 0
+	}
+	return 1 - max(age, 0).Seconds()/d.cfg.MinAccountAge.Seconds()
+}
+
+// deviation is how far the rating is from the customer's average, 1 being the
+// whole 1-5 scale. Customers with too few ratings have no meaningful average.
+func (d *Detector) deviation(rating int, customerRating *domain.CustomerRating) float64 {
+	if customerRating == nil || customerRating.Count == 0 || customerRating.Count < d.cfg.MinRatings {
+		return 0
+	}
+	return math.Abs(float64(rating)-customerRating.Average()) / 4
+}
+
+func saturate(count int, limit int) float64 {
+	if limit <= 0 {
+		return 0
+	}
+	return math.Min(float64(count)/float64(limit), 1)
+}
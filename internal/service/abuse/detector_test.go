@@ -0,0 +1,116 @@
+package abuse
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/utils/config"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDetectorAssess(t *testing.T) {
+	cfg := config.Abuse{
+		Enabled:       true,
+		Window:        time.Hour,
+		VelocityLimit: 4,
+		BurstLimit:    10,
+		MinAccountAge: 48 * time.Hour,
+		MinRatings:    5,
+		HoldThreshold: 0.6,
+	}
+	// an average of 5 over ten ratings
+	established := &domain.CustomerRating{Count: 10, Sum: 50}
+
+	tests := []struct {
+		name    string
+		cfg     config.Abuse
+		signals domain.AbuseSignals
+		want    domain.AbuseAssessment
+	}{
+		{
+			name: "regular feedback",
+			cfg:  cfg,
+			signals: domain.AbuseSignals{
+				UserFeedbacks: 1, CustomerFeedbacks: 1, AccountAge: 720 * time.Hour,
+				Rating: 5, CustomerRating: established,
+			},
+			want: domain.AbuseAssessment{Velocity: 0.25, Burst: 0.1, Score: 0.105},
+		},
+		{
+			name: "coordinated attack",
+			cfg:  cfg,
+			signals: domain.AbuseSignals{
+				UserFeedbacks: 8, CustomerFeedbacks: 20, AccountAge: 0,
+				Rating: 1, CustomerRating: established,
+			},
+			want: domain.AbuseAssessment{Velocity: 1, Burst: 1, AccountAge: 1, Deviation: 1, Score: 1, Held: true},
+		},
+		{
+			name: "young account",
+			cfg:  cfg,
+			signals: domain.AbuseSignals{
+				UserFeedbacks: 2, CustomerFeedbacks: 5, AccountAge: 24 * time.Hour,
+				Rating: 3, CustomerRating: established,
+			},
+			want: domain.AbuseAssessment{Velocity: 0.5, Burst: 0.5, AccountAge: 0.5, Deviation: 0.5, Score: 0.5},
+		},
+		{
+			name: "negative account age counts as new",
+			cfg:  cfg,
+			signals: domain.AbuseSignals{
+				AccountAge: -time.Hour, Rating: 5,
+			},
+			want: domain.AbuseAssessment{AccountAge: 1, Score: 0.2},
+		},
+		{
+			name: "unknown account is neutral",
+			cfg:  cfg,
+			signals: domain.AbuseSignals{
+				UserFeedbacks: 1, CustomerFeedbacks: 1, UnknownAccount: true,
+				Rating: 5, CustomerRating: established,
+			},
+			want: domain.AbuseAssessment{Velocity: 0.25, Burst: 0.1, Score: 0.105},
+		},
+		{
+			name: "too few ratings for deviation",
+			cfg:  cfg,
+			signals: domain.AbuseSignals{
+				AccountAge: 720 * time.Hour, Rating: 1,
+				CustomerRating: &domain.CustomerRating{Count: 3, Sum: 15},
+			},
+			want: domain.AbuseAssessment{},
+		},
+		{
+			name: "no limits configured",
+			cfg:  config.Abuse{Enabled: true},
+			signals: domain.AbuseSignals{
+				UserFeedbacks: 100, CustomerFeedbacks: 100, AccountAge: 0,
+				Rating: 1, CustomerRating: established,
+			},
+			want: domain.AbuseAssessment{Deviation: 1, Score: 0.2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewDetector(tt.cfg).Assess(tt.signals)
+			for _, c := range []struct {
+				name      string
+				got, want float64
+			}{
+				{"velocity", got.Velocity, tt.want.Velocity},
+				{"burst", got.Burst, tt.want.Burst},
+				{"account age", got.AccountAge, tt.want.AccountAge},
+				{"deviation", got.Deviation, tt.want.Deviation},
+				{"score", got.Score, tt.want.Score},
+			} {
+				if math.Abs(c.got-c.want) > 1e-9 {
+					t.Errorf("Assess() %s = %v, want %v", c.name, c.got, c.want)
+				}
+			}
+			if got.Held != tt.want.Held {
+				t.Errorf("Assess() held = %v, want %v", got.Held, tt.want.Held)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// assessAbuse gathers the abuse signals of a new or edited feedback and scores
+// them. It returns nil when abuse detection is off.
+func (s *CustomerService) assessAbuse(ctx context.Context, feedback *domain.Feedback, edited bool) (*domain.AbuseAssessment, error) {
+	if !s.abuse.Enabled() {
+		return nil, nil
+	}
+
+	since := time.Now().Add(-s.abuse.Window())
+	userFeedbacks, err := s.storage.CountFeedbacks(ctx, sql.WithUserID(feedback.UserID), sql.WithCreatedFrom(since))
+	if err != nil {
+		s.log(ctx).Error("failed to count user feedbacks", zap.Error(err), zap.String("user_id", feedback.UserID))
+		return nil, ErrFeedbackInternal
+	}
+	customerFeedbacks, err := s.storage.CountFeedbacks(ctx, sql.WithCustomerID(feedback.CustomerID), sql.WithCreatedFrom(since))
+	if err != nil {
+		s.log(ctx).Error("failed to count customer feedbacks", zap.Error(err), zap.String("customer_id", feedback.CustomerID))
+		return nil, ErrFeedbackInternal
+	}
+	userCreatedAt, err := s.storage.GetUserCreatedAt(ctx, feedback.UserID)
+	if err != nil {
+		return nil, ErrFeedbackInternal
+	}
+	var accountAge time.Duration
+	if !userCreatedAt.IsZero() {
+		accountAge = time.Since(userCreatedAt)
+	}
+	rating, err := s.storage.GetCustomerRating(ctx, feedback.CustomerID, s.revealedFeedbacks())
+	if err != nil {
+		if errors.Is(err, sql.ErrCustomerNotFound) {
+			return nil, ErrFeedbackInvalid
+		}
+		return nil, ErrFeedbackInternal
+	}
+
+	if !edited {
+		// the counts do not include the feedback being written yet
+		userFeedbacks++
+		customerFeedbacks++
+	}
+	assessment := s.abuse.Assess(domain.AbuseSignals{
+		UserFeedbacks:     userFeedbacks,
+		CustomerFeedbacks: customerFeedbacks,
+		AccountAge:        accountAge,
+		UnknownAccount:    userCreatedAt.IsZero(),
+		Rating:            feedback.Rating,
+		CustomerRating:    rating,
+	})
+	if assessment.Held {
+		s.log(ctx).Warn("feedback held for abuse review", zap.String("user_id", feedback.UserID), zap.String("customer_id", feedback.CustomerID), zap.Float64("score", assessment.Score))
+	}
+	return assessment, nil
+}
+
+// fillAbuse attaches the abuse assessments to the feedbacks. They are meant
+// for moderators only.
+func (s *CustomerService) fillAbuse(ctx context.Context, feedbacks ...*domain.Feedback) error {
+	ids := make([]string, 0, len(feedbacks))
+	for _, feedback := range feedbacks {
+		ids = append(ids, feedback.ID)
+	}
+	assessments, err := s.storage.GetFeedbackAbuseAssessments(ctx, ids)
+	if err != nil {
+		s.log(ctx).Error("failed to get feedback abuse assessments", zap.Error(err), zap.Strings("feedback_ids", ids))
+		return ErrFeedbackInternal
+	}
+	for _, feedback := range feedbacks {
+		feedback.Abuse = assessments[feedback.ID]
+	}
+	return nil
+}
@@ -1,13 +1,49 @@
 package customer
 
-import "errors"
+import (
+	"errors"
+	"time"
+)
 
 var ErrCustomerNotFound = errors.New("customer not found")
 var ErrCustomerAlreadyExists = errors.New("customer already exists")
 var ErrCustomerInternal = errors.New("customer internal error")
 var ErrCustomerInvalid = errors.New("customer invalid")
+var ErrCustomerConflict = errors.New("customer was modified concurrently")
+var ErrCustomerRejected = errors.New("customer text rejected by content screening")
 
 var ErrFeedbackNotFound = errors.New("feedback not found")
 var ErrFeedbackInternal = errors.New("feedback internal error")
 var ErrFeedbackInvalid = errors.New("feedback invalid")
-var ErrFeedbackAlreadyExists = errors.New("feedback already exists")
\ No newline at end of file
+var ErrFeedbackAlreadyExists = errors.New("feedback already exists")
+var ErrFeedbackForbidden = errors.New("feedback belongs to another user")
+var ErrFeedbackEditWindowExpired = errors.New("feedback edit window has expired")
+var ErrFeedbackRejected = errors.New("feedback rejected by content screening")
+var ErrFeedbackNotEligible = errors.New("user did not take part in the task")
+var ErrTaskServiceUnavailable = errors.New("task service unavailable, try again later")
+var ErrFeedbackReplyNotFound = errors.New("feedback reply not found")
+var ErrFeedbackReplyAlreadyExists = errors.New("feedback already has a reply")
+var ErrFeedbackReplyInvalid = errors.New("feedback reply invalid")
+var ErrFeedbackReplyForbidden = errors.New("feedback is about another customer")
+var ErrFeedbackReplyRejected = errors.New("feedback reply rejected by content screening")
+
+var ErrReportNotFound = errors.New("no open reports for target")
+var ErrReportAlreadyExists = errors.New("target already reported by this user")
+var ErrReportInvalid = errors.New("report invalid")
+var ErrReportInternal = errors.New("report internal error")
+
+var ErrRateLimited = errors.New("too many requests")
+
+// RateLimitError is returned when a rate limit was hit. It matches
+// ErrRateLimited and tells when to try again.
+type RateLimitError struct {
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return ErrRateLimited.Error() + ", retry after " + e.RetryAfter.Round(time.Second).String()
+}
+
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
@@ -0,0 +1,213 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/service/taskverifier"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// UpdateFeedback lets the author change the rating and comment of their
+// feedback within the configured edit window. The previous text is kept as a
+// revision. Criterion scores are replaced when feedback.Scores is not nil. An
+// edit never publishes feedback, see editedFeedbackStatus.
+func (s *CustomerService) UpdateFeedback(ctx context.Context, userID string, feedback *domain.Feedback) (*domain.Feedback, error) {
+	if feedback.Rating < 1 || feedback.Rating > 5 {
+		return nil, ErrFeedbackInvalid
+	}
+	if err := s.validateScores(feedback.Scores); err != nil {
+		return nil, err
+	}
+	feedback.Status = s.initialFeedbackStatus()
+	if err := s.screenFeedback(ctx, feedback); err != nil {
+		return nil, err
+	}
+
+	var updated *domain.Feedback
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		current, err := s.lockOwnFeedback(ctx, userID, feedback.ID)
+		if err != nil {
+			return err
+		}
+		err = s.storage.CreateFeedbackRevision(ctx, newFeedbackRevision(current, domain.FeedbackRevisionActionUpdate))
+		if err != nil {
+			return err
+		}
+
+		// the edit is scored like new feedback, repeated edits must not get
+		// past the abuse detection
+		edited := *current
+		edited.Rating = feedback.Rating
+		assessment, err := s.assessAbuse(ctx, &edited, true)
+		if err != nil {
+			return err
+		}
+		if assessment != nil {
+			assessment.FeedbackID = current.ID
+			if err := s.storage.SaveFeedbackAbuseAssessment(ctx, assessment); err != nil {
+				return err
+			}
+			if assessment.Held {
+				feedback.Status = domain.FeedbackStatusPending
+			}
+		}
+
+		current.Rating = feedback.Rating
+		current.Comment = feedback.Comment
+		current.Status = editedFeedbackStatus(current.Status, feedback.Status)
+		current.Screening = feedback.Screening
+		updated, err = s.storage.UpdateFeedback(ctx, current)
+		if err != nil {
+			return err
+		}
+		updated.Scores = current.Scores
+		if feedback.Scores != nil {
+			if err := s.storage.DeleteFeedbackScores(ctx, updated.ID); err != nil {
+				return err
+			}
+			if err := s.storage.CreateFeedbackScores(ctx, updated.ID, feedback.Scores); err != nil {
+				return err
+			}
+			updated.Scores = feedback.Scores
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, s.feedbackWriteError(ctx, err, "failed to update feedback", feedback.ID)
+	}
+	return updated, nil
+}
+
+// DeleteFeedback lets the author retract their feedback within the configured
+// edit window. The deleted text is kept as a revision, the reports against it
+// are dropped.
+func (s *CustomerService) DeleteFeedback(ctx context.Context, userID string, id string) error {
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		current, err := s.lockOwnFeedback(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		err = s.storage.CreateFeedbackRevision(ctx, newFeedbackRevision(current, domain.FeedbackRevisionActionDelete))
+		if err != nil {
+			return err
+		}
+		return s.storage.DeleteFeedback(ctx, id)
+	})
+	if err != nil {
+		return s.feedbackWriteError(ctx, err, "failed to delete feedback", id)
+	}
+	return nil
+}
+
+// editedFeedbackStatus is the status of feedback after an edit screened to
+// screened. Pending, rejected and hidden feedback keeps its status, so that
+// editing cannot undo a moderator, the reports or the abuse hold. Published
+// feedback goes back to pending when the edit needs moderation.
+func editedFeedbackStatus(current, screened domain.FeedbackStatus) domain.FeedbackStatus {
+	if current != domain.FeedbackStatusPublished {
+		return current
+	}
+	return screened
+}
+
+func (s *CustomerService) lockOwnFeedback(ctx context.Context, userID string, id string) (*domain.Feedback, error) {
+	feedback, err := s.lockFeedback(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if feedback.UserID != userID {
+		return nil, ErrFeedbackForbidden
+	}
+	if window := s.cfg.Feedback.EditWindow; window > 0 && time.Since(feedback.CreatedAt) > window {
+		return nil, ErrFeedbackEditWindowExpired
+	}
+	return feedback, nil
+}
+
+// lockFeedback locks the feedback row together with its criterion scores.
+func (s *CustomerService) lockFeedback(ctx context.Context, id string) (*domain.Feedback, error) {
+	feedback, err := s.storage.GetFeedbackForUpdate(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	scores, err := s.storage.GetFeedbackScores(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	feedback.Scores = scores[id]
+	return feedback, nil
+}
+
+// validateScores accepts at most one 1-5 score per configured criterion.
+func (s *CustomerService) validateScores(scores []domain.CriterionScore) error {
+	seen := make(map[string]bool, len(scores))
+	for _, score := range scores {
+		if !slices.Contains(s.cfg.Feedback.Criteria, score.Criterion) || seen[score.Criterion] {
+			return ErrFeedbackInvalid
+		}
+		if score.Score < 1 || score.Score > 5 {
+			return ErrFeedbackInvalid
+		}
+		seen[score.Criterion] = true
+	}
+	return nil
+}
+
+// checkParticipation makes sure the user took part in the customer's task.
+// When the task service cannot answer the configured policy decides: fail
+// open lets the feedback through, fail closed refuses it.
+func (s *CustomerService) checkParticipation(ctx context.Context, taskID string, userID string, customerID string) error {
+	participated, err := s.taskVerifier.VerifyParticipation(ctx, taskID, userID, customerID)
+	if err != nil {
+		if !errors.Is(err, taskverifier.ErrUnavailable) {
+			s.log(ctx).Error("task service failed to verify participation", zap.Error(err), zap.String("task_id", taskID), zap.String("user_id", userID))
+			return ErrFeedbackInternal
+		}
+		if s.cfg.Task.FailOpen {
+			s.log(ctx).Warn("task service unavailable, accepting feedback unverified", zap.Error(err), zap.String("task_id", taskID), zap.String("user_id", userID))
+			return nil
+		}
+		s.log(ctx).Error("failed to verify task participation", zap.Error(err), zap.String("task_id", taskID), zap.String("user_id", userID))
+		return ErrTaskServiceUnavailable
+	}
+	if !participated {
+		return ErrFeedbackNotEligible
+	}
+	return nil
+}
+
+func (s *CustomerService) initialFeedbackStatus() domain.FeedbackStatus {
+	if s.cfg.Feedback.Premoderation {
+		return domain.FeedbackStatusPending
+	}
+	return domain.FeedbackStatusPublished
+}
+
+func (s *CustomerService) feedbackWriteError(ctx context.Context, err error, msg string, id string) error {
+	switch {
+	case errors.Is(err, ErrFeedbackForbidden), errors.Is(err, ErrFeedbackEditWindowExpired), errors.Is(err, ErrFeedbackInvalid):
+		return err
+	case errors.Is(err, sql.ErrFeedbackNotFound):
+		return ErrFeedbackNotFound
+	case errors.Is(err, sql.ErrFeedbackInvalid):
+		return ErrFeedbackInvalid
+	}
+	s.log(ctx).Error(msg, zap.Error(err), zap.String("id", id))
+	return ErrFeedbackInternal
+}
+
+func newFeedbackRevision(feedback *domain.Feedback, action domain.FeedbackRevisionAction) *domain.FeedbackRevision {
+	return &domain.FeedbackRevision{
+		FeedbackID: feedback.ID,
+		CustomerID: feedback.CustomerID,
+		UserID:     feedback.UserID,
+		Action:     action,
+		Rating:     feedback.Rating,
+		Comment:    feedback.Comment,
+	}
+}
@@ -0,0 +1,42 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/service/taskverifier"
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestCheckParticipation(t *testing.T) {
+	tests := []struct {
+		name        string
+		participate bool
+		verifyErr   error
+		failOpen    bool
+		wantErr     error
+	}{
+		{name: "participated", participate: true},
+		{name: "not participated", wantErr: ErrFeedbackNotEligible},
+		{name: "unavailable fails closed", verifyErr: taskverifier.ErrUnavailable, wantErr: ErrTaskServiceUnavailable},
+		{name: "unavailable fails open", verifyErr: taskverifier.ErrUnavailable, failOpen: true},
+		{name: "failure never fails open", verifyErr: taskverifier.ErrFailed, failOpen: true, wantErr: ErrFeedbackInternal},
+		{name: "failure fails closed", verifyErr: taskverifier.ErrFailed, wantErr: ErrFeedbackInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := taskverifier.NewMemoryVerifier()
+			if tt.participate {
+				verifier.AddParticipation("task-1", "user-1", "customer-1")
+			}
+			verifier.SetError(tt.verifyErr)
+			s := newTestService(t, &fakeStorage{}, &config.Config{Task: config.Task{FailOpen: tt.failOpen}})
+			s.taskVerifier = verifier
+
+			if err := s.checkParticipation(context.Background(), "task-1", "user-1", "customer-1"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkParticipation() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -2,36 +2,153 @@ package customer
 
 import (
 	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/service/pagination"
+	"DobrikaDev/customer-service/internal/service/ratelimit"
 	"DobrikaDev/customer-service/internal/storage/sql"
 	"context"
 	"errors"
+	"strings"
 
 	"go.uber.org/zap"
 )
 
+// GetCustomerByMaxID looks up a customer the way the public sees it: deleted
+// customers and customers hidden after reports are not found, as in listings
+// and search.
 func (s *CustomerService) GetCustomerByMaxID(ctx context.Context, maxID string) (*domain.Customer, error) {
 	customer, err := s.storage.GetCustomerByMaxID(ctx, maxID)
 	if err != nil {
 		if errors.Is(err, sql.ErrCustomerNotFound) {
 			return nil, ErrCustomerNotFound
 		}
-		s.logger.Error("failed to get customer by max id", zap.Error(err), zap.String("max_id", maxID))
+		s.log(ctx).Error("failed to get customer by max id", zap.Error(err), zap.String("max_id", maxID))
 		return nil, ErrCustomerInternal
 	}
+	if customer.HiddenAt != nil {
+		return nil, ErrCustomerNotFound
+	}
+	if err := s.fillReputation(ctx, customer); err != nil {
+		return nil, err
+	}
 	return customer, nil
 }
 
-func (s *CustomerService) GetCustomers(ctx context.Context, maxID string, limit int, offset int) ([]*domain.Customer, int, error) {
+func (s *CustomerService) GetCustomers(ctx context.Context, filter *domain.CustomerFilter, page domain.Page) ([]*domain.Customer, int, string, error) {
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedFrom.After(filter.CreatedTo) {
+		return nil, 0, "", ErrCustomerInvalid
+	}
+	if !filter.UpdatedFrom.IsZero() && !filter.UpdatedTo.IsZero() && filter.UpdatedFrom.After(filter.UpdatedTo) {
+		return nil, 0, "", ErrCustomerInvalid
+	}
+
+	sortBy, direction := filter.SortBy, filter.SortDirection
+	if sortBy == "" {
+		sortBy = domain.CustomerSortByCreatedAt
+	}
+	if direction == "" {
+		direction = domain.SortDesc
+		if sortBy == domain.CustomerSortByName {
+			direction = domain.SortAsc
+		}
+	}
+	// page tokens encode (created_at, max_id) and only work with the default order
+	keyset := sortBy == domain.CustomerSortByCreatedAt && direction == domain.SortDesc
+
 	opts := []sql.GetCustomersOption{
-		sql.WithCustomerMaxID(maxID),
-		sql.WithCustomerLimit(limit),
-		sql.WithCustomerOffset(offset),
+		sql.WithCustomerMaxID(filter.MaxID),
+		sql.WithCustomerNameLike(filter.NameQuery),
+		sql.WithCustomerType(filter.Type),
+		sql.WithCustomerCreatedFrom(filter.CreatedFrom),
+		sql.WithCustomerCreatedTo(filter.CreatedTo),
+		sql.WithCustomerUpdatedFrom(filter.UpdatedFrom),
+		sql.WithCustomerUpdatedTo(filter.UpdatedTo),
+	}
+	if page.Token != "" {
+		if !keyset {
+			return nil, 0, "", ErrCustomerInvalid
+		}
+		cursor, err := s.pageTokens.Decode(page.Token)
+		if err != nil {
+			return nil, 0, "", ErrCustomerInvalid
+		}
+		opts = append(opts, sql.WithCustomerAfter(cursor.CreatedAt, cursor.ID))
+	} else {
+		opts = append(opts, sql.WithCustomerOffset(page.Offset))
+	}
+	if page.Limit > 0 {
+		// one extra row tells whether there is a next page
+		opts = append(opts, sql.WithCustomerLimit(page.Limit+1))
+	}
+	if sortBy == domain.CustomerSortByReputation {
+		opts = append(opts, sql.WithCustomerSortByReputation(
+			s.reputation.PriorMean(),
+			s.reputation.PriorWeight(),
+			s.reputation.HalfLife(),
+			direction,
+			s.revealedFeedbacks(),
+		))
+	} else {
+		opts = append(opts, sql.WithCustomerSort(sortBy, direction))
 	}
 	customers, count, err := s.storage.GetCustomers(ctx, opts...)
 	if err != nil {
+		return nil, 0, "", ErrCustomerInternal
+	}
+
+	nextPageToken := ""
+	if page.Limit > 0 && len(customers) > page.Limit {
+		customers = customers[:page.Limit]
+		if keyset {
+			last := customers[len(customers)-1]
+			nextPageToken = s.pageTokens.Encode(pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.MaxID})
+		}
+	}
+
+	if err := s.fillReputation(ctx, customers...); err != nil {
+		return nil, 0, "", err
+	}
+	return customers, count, nextPageToken, nil
+}
+
+func (s *CustomerService) SearchCustomers(ctx context.Context, query string, customerType domain.CustomerType, page domain.Page) ([]*domain.CustomerSearchHit, int, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, 0, ErrCustomerInvalid
+	}
+	opts := []sql.GetCustomersOption{
+		sql.WithCustomerType(customerType),
+		sql.WithCustomerLimit(page.Limit),
+		sql.WithCustomerOffset(page.Offset),
+	}
+	hits, count, err := s.storage.SearchCustomers(ctx, query, opts...)
+	if err != nil {
+		s.log(ctx).Error("failed to search customers", zap.Error(err), zap.String("query", query))
 		return nil, 0, ErrCustomerInternal
 	}
-	return customers, count, nil
+
+	customers := make([]*domain.Customer, 0, len(hits))
+	for _, hit := range hits {
+		customers = append(customers, &hit.Customer)
+	}
+	if err := s.fillReputation(ctx, customers...); err != nil {
+		return nil, 0, err
+	}
+	return hits, count, nil
+}
+
+func (s *CustomerService) fillReputation(ctx context.Context, customers ...*domain.Customer) error {
+	ids := make([]string, 0, len(customers))
+	for _, customer := range customers {
+		ids = append(ids, customer.MaxID)
+	}
+	weights, err := s.storage.GetCustomerRatingWeights(ctx, ids, s.reputation.HalfLife(), s.revealedFeedbacks())
+	if err != nil {
+		s.log(ctx).Error("failed to get customer rating weights", zap.Error(err), zap.Strings("max_ids", ids))
+		return ErrCustomerInternal
+	}
+	for _, customer := range customers {
+		customer.Reputation = s.reputation.Score(weights[customer.MaxID])
+	}
+	return nil
 }
 
 func (s *CustomerService) CountCustomers(ctx context.Context, opts ...sql.GetCustomersOption) (int, error) {
@@ -43,53 +160,186 @@ func (s *CustomerService) CountCustomers(ctx context.Context, opts ...sql.GetCus
 }
 
 func (s *CustomerService) CreateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
+	// the new account is no identity yet, the client creating it is
+	caller := ratelimit.CallerFromContext(ctx, "unknown")
+	if err := s.checkRateLimit(ctx, s.cfg.RateLimit.Customer, "customer:"+caller); err != nil {
+		return nil, err
+	}
+	if err := s.screenCustomer(ctx, customer, domain.CustomerUpdatableFields); err != nil {
+		return nil, err
+	}
 	customer, err := s.storage.CreateCustomer(ctx, customer)
 	if err != nil {
 		if errors.Is(err, sql.ErrCustomerAlreadyExists) {
 			return nil, ErrCustomerAlreadyExists
 		}
-		s.logger.Error("failed to create customer", zap.Error(err), zap.Any("customer", customer))
+		s.log(ctx).Error("failed to create customer", zap.Error(err), zap.Any("customer", customer))
 		return nil, ErrCustomerInternal
 	}
+	customer.Reputation = s.reputation.Score(nil)
 	return customer, nil
 }
 
-func (s *CustomerService) UpdateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
-	customer, err := s.storage.UpdateCustomer(ctx, customer)
+// UpdateCustomer overwrites only the listed fields of the customer. An empty
+// list updates every updatable field.
+func (s *CustomerService) UpdateCustomer(ctx context.Context, customer *domain.Customer, fields []string) (*domain.Customer, error) {
+	if len(fields) == 0 {
+		fields = domain.CustomerUpdatableFields
+	}
+	for _, field := range fields {
+		switch field {
+		case domain.CustomerFieldName:
+			if customer.Name == "" {
+				return nil, ErrCustomerInvalid
+			}
+		case domain.CustomerFieldAbout:
+		case domain.CustomerFieldType:
+			if customer.Type == "" {
+				return nil, ErrCustomerInvalid
+			}
+		default:
+			return nil, ErrCustomerInvalid
+		}
+	}
+	if err := s.screenCustomer(ctx, customer, fields); err != nil {
+		return nil, err
+	}
+	customer, err := s.storage.UpdateCustomer(ctx, customer, fields)
 	if err != nil {
 		if errors.Is(err, sql.ErrCustomerNotFound) {
 			return nil, ErrCustomerNotFound
 		}
-		s.logger.Error("failed to update customer", zap.Error(err), zap.Any("customer", customer))
+		if errors.Is(err, sql.ErrCustomerInvalid) {
+			return nil, ErrCustomerInvalid
+		}
+		if errors.Is(err, sql.ErrCustomerConflict) {
+			return nil, ErrCustomerConflict
+		}
+		s.log(ctx).Error("failed to update customer", zap.Error(err), zap.Any("customer", customer))
 		return nil, ErrCustomerInternal
 	}
+	if err := s.fillReputation(ctx, customer); err != nil {
+		return nil, err
+	}
 	return customer, nil
 }
 
-func (s *CustomerService) DeleteCustomer(ctx context.Context, maxID string) error {
-	err := s.storage.DeleteCustomer(ctx, maxID)
+func (s *CustomerService) DeleteCustomer(ctx context.Context, maxID string, expectedVersion int64) error {
+	err := s.storage.DeleteCustomer(ctx, maxID, expectedVersion)
 	if err != nil {
 		if errors.Is(err, sql.ErrCustomerNotFound) {
 			return ErrCustomerNotFound
 		}
-		s.logger.Error("failed to delete customer", zap.Error(err), zap.String("max_id", maxID))
+		if errors.Is(err, sql.ErrCustomerConflict) {
+			return ErrCustomerConflict
+		}
+		s.log(ctx).Error("failed to delete customer", zap.Error(err), zap.String("max_id", maxID))
 		return ErrCustomerInternal
 	}
 	return nil
 }
 
-func (s *CustomerService) GetFeedbacks(ctx context.Context, taskID string, userID string, limit int, offset int) ([]*domain.Feedback, int, error) {
+func (s *CustomerService) RestoreCustomer(ctx context.Context, maxID string) (*domain.Customer, error) {
+	customer, err := s.storage.RestoreCustomer(ctx, maxID)
+	if err != nil {
+		if errors.Is(err, sql.ErrCustomerNotFound) {
+			return nil, ErrCustomerNotFound
+		}
+		s.log(ctx).Error("failed to restore customer", zap.Error(err), zap.String("max_id", maxID))
+		return nil, ErrCustomerInternal
+	}
+	if err := s.fillReputation(ctx, customer); err != nil {
+		return nil, err
+	}
+	return customer, nil
+}
+
+// PurgeCustomer permanently removes a customer that has already been deleted,
+// regardless of the configured retention.
+func (s *CustomerService) PurgeCustomer(ctx context.Context, maxID string) error {
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		return s.storage.PurgeCustomer(ctx, maxID)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrCustomerNotFound) {
+			return ErrCustomerNotFound
+		}
+		s.log(ctx).Error("failed to purge customer", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+	return nil
+}
+
+func (s *CustomerService) GetFeedbacks(ctx context.Context, taskID string, userID string, page domain.Page) ([]*domain.Feedback, int, string, error) {
 	opts := []sql.GetFeedbacksOptions{
 		sql.WithTaskID(taskID),
 		sql.WithUserID(userID),
-		sql.WithLimit(limit),
-		sql.WithOffset(offset),
+		sql.WithStatuses(domain.FeedbackStatusPublished),
+		s.revealedFeedbacks(),
+	}
+	return s.listFeedbacks(ctx, opts, page)
+}
+
+// listFeedbacks fetches one page of feedbacks, by page token when present and
+// by offset otherwise, and returns the token for the next page.
+func (s *CustomerService) listFeedbacks(ctx context.Context, opts []sql.GetFeedbacksOptions, page domain.Page) ([]*domain.Feedback, int, string, error) {
+	if page.Token != "" {
+		cursor, err := s.pageTokens.Decode(page.Token)
+		if err != nil {
+			return nil, 0, "", ErrFeedbackInvalid
+		}
+		opts = append(opts, sql.WithFeedbacksAfter(cursor.CreatedAt, cursor.ID))
+	} else {
+		opts = append(opts, sql.WithOffset(page.Offset))
+	}
+	if page.Limit > 0 {
+		// one extra row tells whether there is a next page
+		opts = append(opts, sql.WithLimit(page.Limit+1))
 	}
 	feedbacks, count, err := s.storage.GetFeedbacks(ctx, opts...)
 	if err != nil {
-		return nil, 0, ErrFeedbackInternal
+		return nil, 0, "", ErrFeedbackInternal
+	}
+
+	nextPageToken := ""
+	if page.Limit > 0 && len(feedbacks) > page.Limit {
+		feedbacks = feedbacks[:page.Limit]
+		last := feedbacks[len(feedbacks)-1]
+		nextPageToken = s.pageTokens.Encode(pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	if err := s.fillFeedbackDetails(ctx, feedbacks...); err != nil {
+		return nil, 0, "", err
+	}
+	return feedbacks, count, nextPageToken, nil
+}
+
+func (s *CustomerService) CountFeedbacks(ctx context.Context, filter *domain.FeedbackFilter) (int, error) {
+	if filter.MinRating < 0 || filter.MinRating > 5 || filter.MaxRating < 0 || filter.MaxRating > 5 {
+		return 0, ErrFeedbackInvalid
+	}
+	if filter.MinRating > 0 && filter.MaxRating > 0 && filter.MinRating > filter.MaxRating {
+		return 0, ErrFeedbackInvalid
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedFrom.After(filter.CreatedTo) {
+		return 0, ErrFeedbackInvalid
+	}
+	opts := []sql.GetFeedbacksOptions{
+		sql.WithTaskID(filter.TaskID),
+		sql.WithUserID(filter.UserID),
+		sql.WithCustomerID(filter.CustomerID),
+		sql.WithMinRating(filter.MinRating),
+		sql.WithMaxRating(filter.MaxRating),
+		sql.WithCreatedFrom(filter.CreatedFrom),
+		sql.WithCreatedTo(filter.CreatedTo),
+		sql.WithStatuses(domain.FeedbackStatusPublished),
+		s.revealedFeedbacks(),
+	}
+	count, err := s.storage.CountFeedbacks(ctx, opts...)
+	if err != nil {
+		s.log(ctx).Error("failed to count feedbacks", zap.Error(err), zap.Any("filter", filter))
+		return 0, ErrFeedbackInternal
 	}
-	return feedbacks, count, nil
+	return count, nil
 }
 
 func (s *CustomerService) GetFeedbackByID(ctx context.Context, id string) (*domain.Feedback, error) {
@@ -98,13 +348,32 @@ func (s *CustomerService) GetFeedbackByID(ctx context.Context, id string) (*doma
 		if errors.Is(err, sql.ErrFeedbackNotFound) {
 			return nil, ErrFeedbackNotFound
 		}
-		s.logger.Error("failed to get feedback by id", zap.Error(err), zap.String("id", id))
+		s.log(ctx).Error("failed to get feedback by id", zap.Error(err), zap.String("id", id))
+		return nil, ErrFeedbackInternal
+	}
+	// only published reviews past the blind period are there for anyone
+	count, err := s.storage.CountFeedbacks(ctx,
+		sql.WithFeedbackID(id),
+		sql.WithStatuses(domain.FeedbackStatusPublished),
+		s.revealedFeedbacks(),
+	)
+	if err != nil {
+		s.log(ctx).Error("failed to check feedback visibility", zap.Error(err), zap.String("id", id))
 		return nil, ErrFeedbackInternal
 	}
+	if count == 0 {
+		return nil, ErrFeedbackNotFound
+	}
+	if err := s.fillFeedbackDetails(ctx, feedback); err != nil {
+		return nil, err
+	}
 	return feedback, nil
 }
 
-func (s *CustomerService) CreateFeedback(ctx context.Context, feedback *domain.Feedback) (*domain.Feedback, error) {
+// CreateFeedback stores a user's feedback about a customer for a task. A user
+// reviews a task once; with upsert a repeated feedback is applied to the
+// existing one as an edit, subject to the same rules as UpdateFeedback.
+func (s *CustomerService) CreateFeedback(ctx context.Context, feedback *domain.Feedback, upsert bool) (*domain.Feedback, error) {
 	if feedback.Rating < 1 || feedback.Rating > 5 {
 		return nil, ErrFeedbackInvalid
 	}
@@ -114,13 +383,134 @@ func (s *CustomerService) CreateFeedback(ctx context.Context, feedback *domain.F
 	if feedback.TaskID == "" {
 		return nil, ErrFeedbackInvalid
 	}
-	feedback, err := s.storage.CreateFeedback(ctx, feedback)
+	if err := s.validateScores(feedback.Scores); err != nil {
+		return nil, err
+	}
+	if err := s.checkRateLimit(ctx, s.cfg.RateLimit.Feedback, "feedback:"+feedback.UserID); err != nil {
+		return nil, err
+	}
+	if err := s.checkParticipation(ctx, feedback.TaskID, feedback.UserID, feedback.CustomerID); err != nil {
+		return nil, err
+	}
+	if upsert {
+		existing, _, err := s.storage.GetFeedbacks(ctx,
+			sql.WithUserID(feedback.UserID),
+			sql.WithTaskID(feedback.TaskID),
+			sql.WithCustomerID(feedback.CustomerID),
+			sql.WithLimit(1),
+		)
+		if err != nil {
+			s.log(ctx).Error("failed to look up existing feedback", zap.Error(err), zap.Any("feedback", feedback))
+			return nil, ErrFeedbackInternal
+		}
+		if len(existing) > 0 {
+			feedback.ID = existing[0].ID
+			// a repeated feedback without scores keeps the stored ones
+			if len(feedback.Scores) == 0 {
+				feedback.Scores = nil
+			}
+			return s.UpdateFeedback(ctx, feedback.UserID, feedback)
+		}
+	}
+	feedback.Status = s.initialFeedbackStatus()
+	if err := s.screenFeedback(ctx, feedback); err != nil {
+		return nil, err
+	}
+	assessment, err := s.assessAbuse(ctx, feedback, false)
+	if err != nil {
+		return nil, err
+	}
+	if assessment != nil && assessment.Held {
+		feedback.Status = domain.FeedbackStatusPending
+	}
+	var created *domain.Feedback
+	err = s.storage.Do(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.storage.CreateFeedback(ctx, feedback)
+		if err != nil {
+			return err
+		}
+		if assessment != nil {
+			assessment.FeedbackID = created.ID
+			if err := s.storage.SaveFeedbackAbuseAssessment(ctx, assessment); err != nil {
+				return err
+			}
+		}
+		if err := s.storage.CreateFeedbackScores(ctx, created.ID, feedback.Scores); err != nil {
+			return err
+		}
+		created.Scores = feedback.Scores
+		return nil
+	})
 	if err != nil {
 		if errors.Is(err, sql.ErrFeedbackAlreadyExists) {
 			return nil, ErrFeedbackAlreadyExists
 		}
-		s.logger.Error("failed to create feedback", zap.Error(err), zap.Any("feedback", feedback))
+		if errors.Is(err, sql.ErrFeedbackInvalid) {
+			return nil, ErrFeedbackInvalid
+		}
+		s.log(ctx).Error("failed to create feedback", zap.Error(err), zap.Any("feedback", feedback))
 		return nil, ErrFeedbackInternal
 	}
-	return feedback, nil
+	return created, nil
+}
+
+func (s *CustomerService) GetCustomerRating(ctx context.Context, customerID string) (*domain.CustomerRating, error) {
+	// reviews still under the blind period must not show through the sums
+	rating, err := s.storage.GetCustomerRating(ctx, customerID, s.revealedFeedbacks())
+	if err != nil {
+		if errors.Is(err, sql.ErrCustomerNotFound) {
+			return nil, ErrCustomerNotFound
+		}
+		s.log(ctx).Error("failed to get customer rating", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrCustomerInternal
+	}
+	criteria, err := s.storage.GetCustomerCriterionRatings(ctx, customerID, s.revealedFeedbacks())
+	if err != nil {
+		return nil, ErrCustomerInternal
+	}
+	rating.Criteria = s.configuredCriteria(criteria)
+	return rating, nil
+}
+
+// configuredCriteria lists the summaries of the configured criteria in
+// configuration order, with empty ones for criteria nobody has scored yet.
+func (s *CustomerService) configuredCriteria(ratings []*domain.CriterionRating) []*domain.CriterionRating {
+	byCriterion := make(map[string]*domain.CriterionRating, len(ratings))
+	for _, rating := range ratings {
+		byCriterion[rating.Criterion] = rating
+	}
+	criteria := make([]*domain.CriterionRating, 0, len(s.cfg.Feedback.Criteria))
+	for _, criterion := range s.cfg.Feedback.Criteria {
+		rating, ok := byCriterion[criterion]
+		if !ok {
+			rating = &domain.CriterionRating{Criterion: criterion}
+		}
+		criteria = append(criteria, rating)
+	}
+	return criteria
+}
+
+// fillFeedbackDetails attaches the customer replies and criterion scores to
+// the feedbacks.
+func (s *CustomerService) fillFeedbackDetails(ctx context.Context, feedbacks ...*domain.Feedback) error {
+	ids := make([]string, 0, len(feedbacks))
+	for _, feedback := range feedbacks {
+		ids = append(ids, feedback.ID)
+	}
+	replies, err := s.storage.GetFeedbackReplies(ctx, ids)
+	if err != nil {
+		s.log(ctx).Error("failed to get feedback replies", zap.Error(err), zap.Strings("feedback_ids", ids))
+		return ErrFeedbackInternal
+	}
+	scores, err := s.storage.GetFeedbackScores(ctx, ids)
+	if err != nil {
+		s.log(ctx).Error("failed to get feedback scores", zap.Error(err), zap.Strings("feedback_ids", ids))
+		return ErrFeedbackInternal
+	}
+	for _, feedback := range feedbacks {
+		feedback.Reply = replies[feedback.ID]
+		feedback.Scores = scores[feedback.ID]
+	}
+	return nil
 }
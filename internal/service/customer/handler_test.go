@@ -0,0 +1,178 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/service/ratelimit"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCountFeedbacks(t *testing.T) {
+	day := time.Date(2025, 11, 20, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		filter     domain.FeedbackFilter
+		storageErr error
+		want       int
+		wantErr    error
+		wantCalled bool
+	}{
+		{name: "no filter", want: 3, wantCalled: true},
+		{name: "rating range", filter: domain.FeedbackFilter{MinRating: 2, MaxRating: 4}, want: 3, wantCalled: true},
+		{name: "single day", filter: domain.FeedbackFilter{CreatedFrom: day, CreatedTo: day.Add(24 * time.Hour)}, want: 3, wantCalled: true},
+		{name: "empty date range", filter: domain.FeedbackFilter{CreatedFrom: day, CreatedTo: day}, want: 3, wantCalled: true},
+		{name: "min rating out of range", filter: domain.FeedbackFilter{MinRating: 6}, wantErr: ErrFeedbackInvalid},
+		{name: "negative max rating", filter: domain.FeedbackFilter{MaxRating: -1}, wantErr: ErrFeedbackInvalid},
+		{name: "min above max", filter: domain.FeedbackFilter{MinRating: 4, MaxRating: 2}, wantErr: ErrFeedbackInvalid},
+		{name: "from after to", filter: domain.FeedbackFilter{CreatedFrom: day.Add(time.Hour), CreatedTo: day}, wantErr: ErrFeedbackInvalid},
+		{name: "storage failure", storageErr: sql.ErrFeedbackInternal, wantErr: ErrFeedbackInternal, wantCalled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			storage := &fakeStorage{countFeedbacks: func(opts ...sql.GetFeedbacksOptions) (int, error) {
+				called = true
+				return 3, tt.storageErr
+			}}
+
+			filter := tt.filter
+			got, err := newTestService(t, storage, nil).CountFeedbacks(context.Background(), &filter)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CountFeedbacks() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got != tt.want {
+				t.Errorf("CountFeedbacks() = %d, want %d", got, tt.want)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("storage called = %v, want %v", called, tt.wantCalled)
+			}
+		})
+	}
+}
+
+func TestGetCustomerByMaxID(t *testing.T) {
+	hiddenAt := time.Now()
+
+	tests := []struct {
+		name       string
+		customer   *domain.Customer
+		storageErr error
+		wantErr    error
+	}{
+		{name: "visible", customer: &domain.Customer{MaxID: "customer-1"}},
+		{name: "hidden after reports", customer: &domain.Customer{MaxID: "customer-1", HiddenAt: &hiddenAt}, wantErr: ErrCustomerNotFound},
+		{name: "deleted", storageErr: sql.ErrCustomerNotFound, wantErr: ErrCustomerNotFound},
+		{name: "storage failure", storageErr: sql.ErrCustomerInternal, wantErr: ErrCustomerInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &fakeStorage{getCustomer: func(string) (*domain.Customer, error) {
+				return tt.customer, tt.storageErr
+			}}
+
+			customer, err := newTestService(t, storage, nil).GetCustomerByMaxID(context.Background(), "customer-1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetCustomerByMaxID() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && customer.MaxID != "customer-1" {
+				t.Errorf("GetCustomerByMaxID() = %+v, want customer-1", customer)
+			}
+		})
+	}
+}
+
+func TestCreateCustomerRateLimitKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		maxIDs  []string
+		wantKey string
+	}{
+		{name: "caller of the interceptor", ctx: ratelimit.WithCaller(context.Background(), "10.1.2.3"), maxIDs: []string{"a", "b"}, wantKey: "customer:10.1.2.3"},
+		{name: "call without interceptor", ctx: context.Background(), maxIDs: []string{"a", "b"}, wantKey: "customer:unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &config.Config{RateLimit: config.RateLimit{
+				Enabled:  true,
+				Customer: config.RateLimitBucket{Limit: 3, Period: 24 * time.Hour},
+			}}
+			storage := &fakeStorage{createCustomer: func(customer *domain.Customer) (*domain.Customer, error) {
+				return customer, nil
+			}}
+			limiter := &recordingLimiter{}
+			service := newTestService(t, storage, cfg)
+			service.limiter = limiter
+
+			// every new account must come out of the same bucket
+			for _, maxID := range tt.maxIDs {
+				if _, err := service.CreateCustomer(tt.ctx, &domain.Customer{MaxID: maxID, Name: "Fund"}); err != nil {
+					t.Fatalf("CreateCustomer(%q) error = %v", maxID, err)
+				}
+			}
+			for _, key := range limiter.keys {
+				if key != tt.wantKey {
+					t.Errorf("bucket key = %q, want %q", key, tt.wantKey)
+				}
+			}
+			if len(limiter.keys) != len(tt.maxIDs) {
+				t.Errorf("buckets taken = %d, want %d", len(limiter.keys), len(tt.maxIDs))
+			}
+		})
+	}
+}
+
+func TestCreateFeedbackUpsertScores(t *testing.T) {
+	stored := []domain.CriterionScore{{Criterion: "safety", Score: 4}}
+	replaced := []domain.CriterionScore{{Criterion: "safety", Score: 2}}
+
+	tests := []struct {
+		name         string
+		scores       []domain.CriterionScore
+		wantScores   []domain.CriterionScore
+		wantReplaced bool
+	}{
+		{name: "no scores keep the stored ones", scores: nil, wantScores: stored},
+		{name: "empty scores keep the stored ones", scores: []domain.CriterionScore{}, wantScores: stored},
+		{name: "new scores replace the stored ones", scores: replaced, wantScores: replaced, wantReplaced: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &feedbackStorage{
+				feedback: &domain.Feedback{
+					ID: "feedback-1", CustomerID: "customer-1", UserID: "user-1", TaskID: "task-1",
+					Rating: 4, Status: domain.FeedbackStatusPublished, CreatedAt: time.Now(),
+				},
+				scores: stored,
+			}
+			cfg := &config.Config{Feedback: config.Feedback{Criteria: []string{"safety"}}}
+			service := newTestService(t, storage, cfg)
+
+			updated, err := service.CreateFeedback(context.Background(), &domain.Feedback{
+				CustomerID: "customer-1", UserID: "user-1", TaskID: "task-1", Rating: 5, Scores: tt.scores,
+			}, true)
+			if err != nil {
+				t.Fatalf("CreateFeedback() error = %v", err)
+			}
+			if updated.ID != "feedback-1" || updated.Rating != 5 {
+				t.Errorf("CreateFeedback() = %+v, want feedback-1 edited to 5", updated)
+			}
+			if !slices.Equal(updated.Scores, tt.wantScores) || !slices.Equal(storage.scores, tt.wantScores) {
+				t.Errorf("CreateFeedback() scores = %v, stored %v, want %v", updated.Scores, storage.scores, tt.wantScores)
+			}
+			if storage.replacedScores != tt.wantReplaced {
+				t.Errorf("scores replaced = %v, want %v", storage.replacedScores, tt.wantReplaced)
+			}
+		})
+	}
+}
@@ -2,32 +2,93 @@ package customer
 
 import (
 	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/service/abuse"
+	"DobrikaDev/customer-service/internal/service/pagination"
+	"DobrikaDev/customer-service/internal/service/ratelimit"
+	"DobrikaDev/customer-service/internal/service/reputation"
+	"DobrikaDev/customer-service/internal/service/screening"
+	"DobrikaDev/customer-service/internal/service/taskverifier"
 	"DobrikaDev/customer-service/internal/storage/sql"
 	"DobrikaDev/customer-service/utils/config"
+	"DobrikaDev/customer-service/utils/logger"
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 )
 
 type storage interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+
 	GetCustomerByMaxID(ctx context.Context, maxID string) (*domain.Customer, error)
 	GetCustomers(ctx context.Context, opts ...sql.GetCustomersOption) ([]*domain.Customer, int, error)
 	CountCustomers(ctx context.Context, opts ...sql.GetCustomersOption) (int, error)
+	SearchCustomers(ctx context.Context, query string, opts ...sql.GetCustomersOption) ([]*domain.CustomerSearchHit, int, error)
 	CreateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error)
-	UpdateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error)
-	DeleteCustomer(ctx context.Context, maxID string) error
+	UpdateCustomer(ctx context.Context, customer *domain.Customer, fields []string) (*domain.Customer, error)
+	DeleteCustomer(ctx context.Context, maxID string, expectedVersion int64) error
+	RestoreCustomer(ctx context.Context, maxID string) (*domain.Customer, error)
+	GetDeletedCustomerIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error)
+	PurgeCustomer(ctx context.Context, maxID string) error
 
 	GetFeedbacks(ctx context.Context, opts ...sql.GetFeedbacksOptions) ([]*domain.Feedback, int, error)
+	CountFeedbacks(ctx context.Context, opts ...sql.GetFeedbacksOptions) (int, error)
 	GetFeedbackByID(ctx context.Context, id string) (*domain.Feedback, error)
 	CreateFeedback(ctx context.Context, feedback *domain.Feedback) (*domain.Feedback, error)
+	GetFeedbackForUpdate(ctx context.Context, id string) (*domain.Feedback, error)
+	UpdateFeedback(ctx context.Context, feedback *domain.Feedback) (*domain.Feedback, error)
+	DeleteFeedback(ctx context.Context, id string) error
+	CreateFeedbackRevision(ctx context.Context, revision *domain.FeedbackRevision) error
+	SetFeedbackStatus(ctx context.Context, id string, status domain.FeedbackStatus) (*domain.Feedback, error)
+	CreateFeedbackModeration(ctx context.Context, moderation *domain.FeedbackModeration) error
+	GetLatestFeedbackModeration(ctx context.Context, feedbackID string) (*domain.FeedbackModeration, error)
+
+	CreateFeedbackReply(ctx context.Context, reply *domain.FeedbackReply) (*domain.FeedbackReply, error)
+	UpdateFeedbackReply(ctx context.Context, reply *domain.FeedbackReply) (*domain.FeedbackReply, error)
+	DeleteFeedbackReply(ctx context.Context, feedbackID string) error
+	GetFeedbackReplies(ctx context.Context, feedbackIDs []string) (map[string]*domain.FeedbackReply, error)
+
+	CreateFeedbackScores(ctx context.Context, feedbackID string, scores []domain.CriterionScore) error
+	DeleteFeedbackScores(ctx context.Context, feedbackID string) error
+	GetFeedbackScores(ctx context.Context, feedbackIDs []string) (map[string][]domain.CriterionScore, error)
+	GetCustomerCriterionRatings(ctx context.Context, customerID string, opts ...sql.GetFeedbacksOptions) ([]*domain.CriterionRating, error)
+
+	CreateVolunteerFeedback(ctx context.Context, feedback *domain.VolunteerFeedback) (*domain.VolunteerFeedback, error)
+	GetVolunteerFeedbacks(ctx context.Context, opts ...sql.GetFeedbacksOptions) ([]*domain.VolunteerFeedback, int, error)
+	GetVolunteerRating(ctx context.Context, userID string, opts ...sql.GetFeedbacksOptions) (*domain.VolunteerRating, error)
+
+	SaveFeedbackAbuseAssessment(ctx context.Context, assessment *domain.AbuseAssessment) error
+	GetFeedbackAbuseAssessments(ctx context.Context, feedbackIDs []string) (map[string]*domain.AbuseAssessment, error)
+	GetUserCreatedAt(ctx context.Context, userID string) (time.Time, error)
+
+	CreateReport(ctx context.Context, report *domain.Report) (*domain.Report, error)
+	GetReports(ctx context.Context, opts ...sql.GetReportsOption) ([]*domain.Report, int, error)
+	CountReports(ctx context.Context, opts ...sql.GetReportsOption) (int, error)
+	ResolveReports(ctx context.Context, resolution *domain.ReportResolution) ([]*domain.Report, error)
+	LockCustomer(ctx context.Context, maxID string) error
+	SetCustomerHidden(ctx context.Context, maxID string, hidden bool) error
+
+	GetCustomerRating(ctx context.Context, customerID string, opts ...sql.GetFeedbacksOptions) (*domain.CustomerRating, error)
+	GetCustomerRatingWeights(ctx context.Context, customerIDs []string, halfLife time.Duration, opts ...sql.GetFeedbacksOptions) (map[string]*domain.WeightedRating, error)
 }
 
 type CustomerService struct {
-	storage storage
-	cfg     *config.Config
-	logger  *zap.Logger
+	storage       storage
+	reputation    *reputation.Scorer
+	pageTokens    *pagination.Signer
+	contentFilter screening.ContentFilter
+	taskVerifier  taskverifier.TaskVerifier
+	abuse         *abuse.Detector
+	limiter       ratelimit.Limiter
+	cfg           *config.Config
+	logger        *zap.Logger
+}
+
+func NewCustomerService(storage storage, reputation *reputation.Scorer, pageTokens *pagination.Signer, contentFilter screening.ContentFilter, taskVerifier taskverifier.TaskVerifier, abuse *abuse.Detector, limiter ratelimit.Limiter, cfg *config.Config, logger *zap.Logger) *CustomerService {
+	return &CustomerService{storage: storage, reputation: reputation, pageTokens: pageTokens, contentFilter: contentFilter, taskVerifier: taskVerifier, abuse: abuse, limiter: limiter, cfg: cfg, logger: logger}
 }
 
-func NewCustomerService(storage storage, cfg *config.Config, logger *zap.Logger) *CustomerService {
-	return &CustomerService{storage: storage, cfg: cfg, logger: logger}
+// log returns the request-scoped logger of ctx.
+func (s *CustomerService) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, s.logger)
 }
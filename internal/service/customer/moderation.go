@@ -0,0 +1,68 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"context"
+)
+
+// ListFeedbacksForModeration returns feedbacks in the given status, pending
+// ones when status is empty, together with their abuse assessments.
+func (s *CustomerService) ListFeedbacksForModeration(ctx context.Context, status domain.FeedbackStatus, customerID string, page domain.Page) ([]*domain.Feedback, int, string, error) {
+	if status == "" {
+		status = domain.FeedbackStatusPending
+	}
+	opts := []sql.GetFeedbacksOptions{
+		sql.WithCustomerID(customerID),
+		sql.WithStatuses(status),
+	}
+	feedbacks, count, nextPageToken, err := s.listFeedbacks(ctx, opts, page)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if err := s.fillAbuse(ctx, feedbacks...); err != nil {
+		return nil, 0, "", err
+	}
+	return feedbacks, count, nextPageToken, nil
+}
+
+// ModerateFeedback moves a feedback to the decided status, records who made
+// the decision and why, and updates the customer rating accordingly.
+func (s *CustomerService) ModerateFeedback(ctx context.Context, moderation *domain.FeedbackModeration) (*domain.Feedback, error) {
+	switch moderation.Decision {
+	case domain.FeedbackStatusPublished, domain.FeedbackStatusRejected, domain.FeedbackStatusHidden:
+	default:
+		return nil, ErrFeedbackInvalid
+	}
+	if moderation.ModeratorID == "" {
+		return nil, ErrFeedbackInvalid
+	}
+
+	var moderated *domain.Feedback
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		current, err := s.lockFeedback(ctx, moderation.FeedbackID)
+		if err != nil {
+			return err
+		}
+		moderated, err = s.setFeedbackStatus(ctx, current, moderation)
+		return err
+	})
+	if err != nil {
+		return nil, s.feedbackWriteError(ctx, err, "failed to moderate feedback", moderation.FeedbackID)
+	}
+	return moderated, nil
+}
+
+// setFeedbackStatus applies a moderation decision to a feedback locked by the
+// caller and records it.
+func (s *CustomerService) setFeedbackStatus(ctx context.Context, current *domain.Feedback, moderation *domain.FeedbackModeration) (*domain.Feedback, error) {
+	moderated, err := s.storage.SetFeedbackStatus(ctx, current.ID, moderation.Decision)
+	if err != nil {
+		return nil, err
+	}
+	moderated.Scores = current.Scores
+	if err := s.storage.CreateFeedbackModeration(ctx, moderation); err != nil {
+		return nil, err
+	}
+	return moderated, nil
+}
@@ -0,0 +1,60 @@
+package customer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// RunPurger periodically purges customers that were deleted longer than the
+// configured retention ago. It blocks until ctx is cancelled.
+func (s *CustomerService) RunPurger(ctx context.Context) {
+	if s.cfg.Purge.Retention <= 0 || s.cfg.Purge.Interval <= 0 {
+		s.log(ctx).Info("customer purger disabled")
+		return
+	}
+
+	ticker := time.NewTicker(s.cfg.Purge.Interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := s.PurgeDeletedCustomers(ctx)
+		if err != nil {
+			s.log(ctx).Error("failed to purge deleted customers", zap.Error(err))
+		} else if purged > 0 {
+			s.log(ctx).Info("deleted customers purged", zap.Int("count", purged))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeDeletedCustomers removes one batch of customers whose retention has
+// expired and returns how many were purged. A customer that fails to purge is
+// logged and left for the next run.
+func (s *CustomerService) PurgeDeletedCustomers(ctx context.Context) (int, error) {
+	batchSize := s.cfg.Purge.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	ids, err := s.storage.GetDeletedCustomerIDs(ctx, time.Now().Add(-s.cfg.Purge.Retention), batchSize)
+	if err != nil {
+		return 0, ErrCustomerInternal
+	}
+
+	purged := 0
+	for _, id := range ids {
+		if err := s.PurgeCustomer(ctx, id); err != nil {
+			s.log(ctx).Error("failed to purge deleted customer", zap.Error(err), zap.String("max_id", id))
+			continue
+		}
+		purged++
+	}
+	return purged, nil
+}
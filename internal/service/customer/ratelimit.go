@@ -0,0 +1,26 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// checkRateLimit takes a token from the bucket of key. When the limiter itself
+// fails the request is let through rather than blocking all writes.
+func (s *CustomerService) checkRateLimit(ctx context.Context, bucket config.RateLimitBucket, key string) error {
+	if !s.cfg.RateLimit.Enabled {
+		return nil
+	}
+
+	decision, err := s.limiter.Allow(ctx, key, bucket)
+	if err != nil {
+		s.log(ctx).Warn("rate limiter failed, letting request through", zap.Error(err), zap.String("key", key))
+		return nil
+	}
+	if !decision.Allowed {
+		return &RateLimitError{RetryAfter: decision.RetryAfter}
+	}
+	return nil
+}
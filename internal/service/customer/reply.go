@@ -0,0 +1,131 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+// ReplyToFeedback publishes the answer of the customer the feedback is about.
+// Only that customer may reply, once per published feedback.
+func (s *CustomerService) ReplyToFeedback(ctx context.Context, reply *domain.FeedbackReply) (*domain.FeedbackReply, error) {
+	if err := s.screenReply(ctx, reply); err != nil {
+		return nil, err
+	}
+
+	var created *domain.FeedbackReply
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkReplyTarget(ctx, reply.CustomerID, reply.FeedbackID); err != nil {
+			return err
+		}
+		var err error
+		created, err = s.storage.CreateFeedbackReply(ctx, reply)
+		return err
+	})
+	if err != nil {
+		return nil, s.replyWriteError(ctx, err, "failed to create feedback reply", reply.FeedbackID)
+	}
+	return created, nil
+}
+
+func (s *CustomerService) UpdateFeedbackReply(ctx context.Context, reply *domain.FeedbackReply) (*domain.FeedbackReply, error) {
+	if err := s.screenReply(ctx, reply); err != nil {
+		return nil, err
+	}
+
+	var updated *domain.FeedbackReply
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkReplyTarget(ctx, reply.CustomerID, reply.FeedbackID); err != nil {
+			return err
+		}
+		var err error
+		updated, err = s.storage.UpdateFeedbackReply(ctx, reply)
+		return err
+	})
+	if err != nil {
+		return nil, s.replyWriteError(ctx, err, "failed to update feedback reply", reply.FeedbackID)
+	}
+	return updated, nil
+}
+
+func (s *CustomerService) DeleteFeedbackReply(ctx context.Context, customerID string, feedbackID string) error {
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		if err := s.checkReplyOwner(ctx, customerID, feedbackID); err != nil {
+			return err
+		}
+		return s.storage.DeleteFeedbackReply(ctx, feedbackID)
+	})
+	if err != nil {
+		return s.replyWriteError(ctx, err, "failed to delete feedback reply", feedbackID)
+	}
+	return nil
+}
+
+// checkReplyOwner locks the feedback so it cannot be deleted while the reply
+// is written, and makes sure it is about the given customer.
+func (s *CustomerService) checkReplyOwner(ctx context.Context, customerID string, feedbackID string) error {
+	_, err := s.lockReplyFeedback(ctx, customerID, feedbackID)
+	return err
+}
+
+// checkReplyTarget is checkReplyOwner for writing a reply, which is only
+// possible on published feedback.
+func (s *CustomerService) checkReplyTarget(ctx context.Context, customerID string, feedbackID string) error {
+	feedback, err := s.lockReplyFeedback(ctx, customerID, feedbackID)
+	if err != nil {
+		return err
+	}
+	if feedback.Status != domain.FeedbackStatusPublished {
+		return ErrFeedbackNotFound
+	}
+	return nil
+}
+
+func (s *CustomerService) lockReplyFeedback(ctx context.Context, customerID string, feedbackID string) (*domain.Feedback, error) {
+	feedback, err := s.storage.GetFeedbackForUpdate(ctx, feedbackID)
+	if err != nil {
+		return nil, err
+	}
+	if feedback.CustomerID != customerID {
+		return nil, ErrFeedbackReplyForbidden
+	}
+	return feedback, nil
+}
+
+// screenReply validates the reply text and runs it through the content
+// filter. Replies have no moderation queue, so a moderate verdict rejects them
+// like a reject verdict does.
+func (s *CustomerService) screenReply(ctx context.Context, reply *domain.FeedbackReply) error {
+	if reply.FeedbackID == "" || reply.CustomerID == "" || reply.Text == "" {
+		return ErrFeedbackReplyInvalid
+	}
+	result, err := s.contentFilter.Screen(ctx, reply.Text)
+	if err != nil {
+		s.log(ctx).Error("failed to screen feedback reply", zap.Error(err), zap.String("feedback_id", reply.FeedbackID))
+		return ErrFeedbackInternal
+	}
+	reply.Text = result.Text
+	reply.Screening = result.Verdict
+	if result.Verdict != nil && (result.Verdict.Action == domain.ScreeningActionReject || result.Verdict.Action == domain.ScreeningActionModerate) {
+		return ErrFeedbackReplyRejected
+	}
+	return nil
+}
+
+func (s *CustomerService) replyWriteError(ctx context.Context, err error, msg string, feedbackID string) error {
+	switch {
+	case errors.Is(err, ErrFeedbackReplyForbidden), errors.Is(err, ErrFeedbackNotFound):
+		return err
+	case errors.Is(err, sql.ErrFeedbackNotFound):
+		return ErrFeedbackNotFound
+	case errors.Is(err, sql.ErrFeedbackReplyNotFound):
+		return ErrFeedbackReplyNotFound
+	case errors.Is(err, sql.ErrFeedbackReplyAlreadyExists):
+		return ErrFeedbackReplyAlreadyExists
+	}
+	s.log(ctx).Error(msg, zap.Error(err), zap.String("feedback_id", feedbackID))
+	return ErrFeedbackInternal
+}
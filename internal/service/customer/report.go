@@ -0,0 +1,256 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/service/pagination"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+)
+
+// reportsModeratorID is recorded as the moderator of feedback hidden after
+// reports, before any admin has looked at it.
+const reportsModeratorID = "reports"
+
+// ReportCustomer files a report against a customer and hides the customer from
+// listings once enough distinct users have reported it.
+func (s *CustomerService) ReportCustomer(ctx context.Context, report *domain.Report) (*domain.Report, error) {
+	report.TargetType = domain.ReportTargetCustomer
+	if err := validateReport(report); err != nil {
+		return nil, err
+	}
+	if report.ReporterID == report.TargetID {
+		return nil, ErrReportInvalid
+	}
+
+	var created *domain.Report
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		if err := s.storage.LockCustomer(ctx, report.TargetID); err != nil {
+			return err
+		}
+		var err error
+		created, err = s.storage.CreateReport(ctx, report)
+		if err != nil {
+			return err
+		}
+		count, err := s.openReportsToHide(ctx, report)
+		if err != nil || count == 0 {
+			return err
+		}
+		s.log(ctx).Info("hiding reported customer", zap.String("max_id", report.TargetID), zap.Int("reports", count))
+		return s.storage.SetCustomerHidden(ctx, report.TargetID, true)
+	})
+	if err != nil {
+		return nil, s.reportWriteError(ctx, err, "failed to report customer", report.TargetID)
+	}
+	return created, nil
+}
+
+// ReportFeedback files a report against a feedback and hides the feedback once
+// enough distinct users have reported it. Authors cannot report their own
+// feedback.
+func (s *CustomerService) ReportFeedback(ctx context.Context, report *domain.Report) (*domain.Report, error) {
+	report.TargetType = domain.ReportTargetFeedback
+	if err := validateReport(report); err != nil {
+		return nil, err
+	}
+
+	var created *domain.Report
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		current, err := s.lockFeedback(ctx, report.TargetID)
+		if err != nil {
+			return err
+		}
+		if current.UserID == report.ReporterID {
+			return ErrReportInvalid
+		}
+		created, err = s.storage.CreateReport(ctx, report)
+		if err != nil {
+			return err
+		}
+		count, err := s.openReportsToHide(ctx, report)
+		if err != nil || count == 0 || current.Status != domain.FeedbackStatusPublished {
+			return err
+		}
+		s.log(ctx).Info("hiding reported feedback", zap.String("id", report.TargetID), zap.Int("reports", count))
+		_, err = s.setFeedbackStatus(ctx, current, &domain.FeedbackModeration{
+			FeedbackID:  current.ID,
+			ModeratorID: reportsModeratorID,
+			Decision:    domain.FeedbackStatusHidden,
+			Reason:      fmt.Sprintf("hidden after %d reports", count),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, s.reportWriteError(ctx, err, "failed to report feedback", report.TargetID)
+	}
+	return created, nil
+}
+
+func (s *CustomerService) ListReports(ctx context.Context, filter *domain.ReportFilter, page domain.Page) ([]*domain.Report, int, string, error) {
+	opts := []sql.GetReportsOption{
+		sql.WithReportTargetType(filter.TargetType),
+		sql.WithReportTargetID(filter.TargetID),
+		sql.WithReportStatus(filter.Status),
+		sql.WithReportReason(filter.Reason),
+	}
+	if page.Token != "" {
+		cursor, err := s.pageTokens.Decode(page.Token)
+		if err != nil {
+			return nil, 0, "", ErrReportInvalid
+		}
+		opts = append(opts, sql.WithReportsAfter(cursor.CreatedAt, cursor.ID))
+	} else {
+		opts = append(opts, sql.WithReportOffset(page.Offset))
+	}
+	if page.Limit > 0 {
+		// one extra row tells whether there is a next page
+		opts = append(opts, sql.WithReportLimit(page.Limit+1))
+	}
+	reports, count, err := s.storage.GetReports(ctx, opts...)
+	if err != nil {
+		return nil, 0, "", ErrReportInternal
+	}
+
+	nextPageToken := ""
+	if page.Limit > 0 && len(reports) > page.Limit {
+		reports = reports[:page.Limit]
+		last := reports[len(reports)-1]
+		nextPageToken = s.pageTokens.Encode(pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return reports, count, nextPageToken, nil
+}
+
+// ResolveReports closes the open reports of a target, failing with
+// ErrReportNotFound when there are none. Upholding them hides the target;
+// dismissing them shows a customer again, and a feedback again when its last
+// moderation was the hiding by reports.
+func (s *CustomerService) ResolveReports(ctx context.Context, resolution *domain.ReportResolution) ([]*domain.Report, error) {
+	switch resolution.Decision {
+	case domain.ReportStatusUpheld, domain.ReportStatusDismissed:
+	default:
+		return nil, ErrReportInvalid
+	}
+	if resolution.TargetID == "" || resolution.ResolverID == "" {
+		return nil, ErrReportInvalid
+	}
+	upheld := resolution.Decision == domain.ReportStatusUpheld
+
+	var resolved []*domain.Report
+	err := s.storage.Do(ctx, func(ctx context.Context) error {
+		switch resolution.TargetType {
+		case domain.ReportTargetCustomer:
+			if err := s.storage.LockCustomer(ctx, resolution.TargetID); err != nil {
+				return err
+			}
+			var err error
+			resolved, err = s.storage.ResolveReports(ctx, resolution)
+			if err != nil {
+				return err
+			}
+			return s.storage.SetCustomerHidden(ctx, resolution.TargetID, upheld)
+		case domain.ReportTargetFeedback:
+			current, err := s.lockFeedback(ctx, resolution.TargetID)
+			if err != nil {
+				return err
+			}
+			resolved, err = s.storage.ResolveReports(ctx, resolution)
+			if err != nil {
+				return err
+			}
+			decision := current.Status
+			switch {
+			case upheld && (current.Status == domain.FeedbackStatusPublished || current.Status == domain.FeedbackStatusPending):
+				decision = domain.FeedbackStatusHidden
+			case !upheld && current.Status == domain.FeedbackStatusHidden:
+				hiddenByReports, err := s.hiddenByReportsOnly(ctx, current.ID)
+				if err != nil {
+					return err
+				}
+				if hiddenByReports {
+					decision = domain.FeedbackStatusPublished
+				}
+			}
+			if decision == current.Status {
+				return nil
+			}
+			_, err = s.setFeedbackStatus(ctx, current, &domain.FeedbackModeration{
+				FeedbackID:  current.ID,
+				ModeratorID: resolution.ResolverID,
+				Decision:    decision,
+				Reason:      resolution.Note,
+			})
+			return err
+		default:
+			return ErrReportInvalid
+		}
+	})
+	if err != nil {
+		return nil, s.reportWriteError(ctx, err, "failed to resolve reports", resolution.TargetID)
+	}
+	return resolved, nil
+}
+
+// openReportsToHide returns the number of open reports against the target of
+// report when it has reached the hide threshold, and zero otherwise.
+func (s *CustomerService) openReportsToHide(ctx context.Context, report *domain.Report) (int, error) {
+	if s.cfg.Reports.HideThreshold <= 0 {
+		return 0, nil
+	}
+	count, err := s.storage.CountReports(ctx,
+		sql.WithReportTargetType(report.TargetType),
+		sql.WithReportTargetID(report.TargetID),
+		sql.WithReportStatus(domain.ReportStatusOpen),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if !s.hiddenByReports(count) {
+		return 0, nil
+	}
+	return count, nil
+}
+
+// hiddenByReportsOnly tells whether the last moderation of a feedback was its
+// hiding after reports, so that dismissing them does not undo an admin.
+func (s *CustomerService) hiddenByReportsOnly(ctx context.Context, feedbackID string) (bool, error) {
+	moderation, err := s.storage.GetLatestFeedbackModeration(ctx, feedbackID)
+	if err != nil {
+		if errors.Is(err, sql.ErrFeedbackModerationNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return moderation.ModeratorID == reportsModeratorID, nil
+}
+
+func (s *CustomerService) hiddenByReports(openReports int) bool {
+	return s.cfg.Reports.HideThreshold > 0 && openReports >= s.cfg.Reports.HideThreshold
+}
+
+func validateReport(report *domain.Report) error {
+	if report.TargetID == "" || report.ReporterID == "" || !report.Reason.Valid() {
+		return ErrReportInvalid
+	}
+	return nil
+}
+
+func (s *CustomerService) reportWriteError(ctx context.Context, err error, msg string, targetID string) error {
+	switch {
+	case errors.Is(err, ErrReportInvalid):
+		return err
+	case errors.Is(err, sql.ErrReportAlreadyExists):
+		return ErrReportAlreadyExists
+	case errors.Is(err, sql.ErrReportNotFound):
+		return ErrReportNotFound
+	case errors.Is(err, sql.ErrCustomerNotFound):
+		return ErrCustomerNotFound
+	case errors.Is(err, sql.ErrFeedbackNotFound):
+		return ErrFeedbackNotFound
+	}
+	s.log(ctx).Error(msg, zap.Error(err), zap.String("target_id", targetID))
+	return ErrReportInternal
+}
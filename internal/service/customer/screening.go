@@ -0,0 +1,64 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+)
+
+// screenFeedback runs the comment through the content filter before it is
+// stored: rejected comments fail with ErrFeedbackRejected, masked fragments are
+// replaced and suspicious comments wait for a moderator.
+func (s *CustomerService) screenFeedback(ctx context.Context, feedback *domain.Feedback) error {
+	result, err := s.contentFilter.Screen(ctx, feedback.Comment)
+	if err != nil {
+		s.log(ctx).Error("failed to screen feedback", zap.Error(err), zap.String("id", feedback.ID))
+		return ErrFeedbackInternal
+	}
+
+	feedback.Comment = result.Text
+	feedback.Screening = result.Verdict
+	if result.Verdict == nil {
+		return nil
+	}
+	switch result.Verdict.Action {
+	case domain.ScreeningActionReject:
+		return ErrFeedbackRejected
+	case domain.ScreeningActionModerate:
+		feedback.Status = domain.FeedbackStatusPending
+	}
+	return nil
+}
+
+// screenCustomer screens the name and about text among the given fields.
+// Customers have no moderation queue, so a moderate verdict is only stored for
+// later review.
+func (s *CustomerService) screenCustomer(ctx context.Context, customer *domain.Customer, fields []string) error {
+	var verdict *domain.ScreeningVerdict
+	for _, field := range []struct {
+		name string
+		text *string
+	}{
+		{domain.CustomerFieldName, &customer.Name},
+		{domain.CustomerFieldAbout, &customer.About},
+	} {
+		if !slices.Contains(fields, field.name) {
+			continue
+		}
+		result, err := s.contentFilter.Screen(ctx, *field.text)
+		if err != nil {
+			s.log(ctx).Error("failed to screen customer", zap.Error(err), zap.String("max_id", customer.MaxID))
+			return ErrCustomerInternal
+		}
+		*field.text = result.Text
+		verdict = verdict.Merge(result.Verdict)
+	}
+
+	customer.Screening = verdict
+	if verdict != nil && verdict.Action == domain.ScreeningActionReject {
+		return ErrCustomerRejected
+	}
+	return nil
+}
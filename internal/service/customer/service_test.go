@@ -0,0 +1,113 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/service/abuse"
+	"DobrikaDev/customer-service/internal/service/ratelimit"
+	"DobrikaDev/customer-service/internal/service/reputation"
+	"DobrikaDev/customer-service/internal/service/screening"
+	"DobrikaDev/customer-service/internal/service/taskverifier"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// fakeStorage answers the storage calls a test sets up; any other call
+// panics on the nil embedded interface.
+type fakeStorage struct {
+	storage
+
+	countFeedbacks func(opts ...sql.GetFeedbacksOptions) (int, error)
+	createCustomer func(customer *domain.Customer) (*domain.Customer, error)
+	getCustomer    func(maxID string) (*domain.Customer, error)
+}
+
+func (f *fakeStorage) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (f *fakeStorage) CountFeedbacks(_ context.Context, opts ...sql.GetFeedbacksOptions) (int, error) {
+	return f.countFeedbacks(opts...)
+}
+
+func (f *fakeStorage) CreateCustomer(_ context.Context, customer *domain.Customer) (*domain.Customer, error) {
+	return f.createCustomer(customer)
+}
+
+func (f *fakeStorage) GetCustomerByMaxID(_ context.Context, maxID string) (*domain.Customer, error) {
+	return f.getCustomer(maxID)
+}
+
+func (f *fakeStorage) GetCustomerRatingWeights(_ context.Context, _ []string, _ time.Duration, _ ...sql.GetFeedbacksOptions) (map[string]*domain.WeightedRating, error) {
+	return map[string]*domain.WeightedRating{}, nil
+}
+
+// recordingLimiter allows every call and remembers the buckets taken from.
+type recordingLimiter struct {
+	keys []string
+}
+
+func (l *recordingLimiter) Allow(_ context.Context, key string, _ config.RateLimitBucket) (ratelimit.Decision, error) {
+	l.keys = append(l.keys, key)
+	return ratelimit.Decision{Allowed: true}, nil
+}
+
+func newTestService(t *testing.T, storage storage, cfg *config.Config) *CustomerService {
+	t.Helper()
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+	contentFilter, err := screening.NewRuleEngine(cfg.Screening)
+	if err != nil {
+		t.Fatalf("NewRuleEngine() error = %v", err)
+	}
+	return NewCustomerService(storage, reputation.NewScorer(cfg.Reputation), nil, contentFilter,
+		taskverifier.NewAllowAllVerifier(), abuse.NewDetector(cfg.Abuse), ratelimit.NewMemoryLimiter(0), cfg, zap.NewNop())
+}
+
+// feedbackStorage keeps a single feedback and records how its scores are
+// rewritten.
+type feedbackStorage struct {
+	fakeStorage
+
+	feedback       *domain.Feedback
+	scores         []domain.CriterionScore
+	replacedScores bool
+}
+
+func (f *feedbackStorage) GetFeedbacks(_ context.Context, _ ...sql.GetFeedbacksOptions) ([]*domain.Feedback, int, error) {
+	return []*domain.Feedback{f.feedback}, 1, nil
+}
+
+func (f *feedbackStorage) GetFeedbackForUpdate(_ context.Context, _ string) (*domain.Feedback, error) {
+	feedback := *f.feedback
+	return &feedback, nil
+}
+
+func (f *feedbackStorage) GetFeedbackScores(_ context.Context, ids []string) (map[string][]domain.CriterionScore, error) {
+	return map[string][]domain.CriterionScore{ids[0]: f.scores}, nil
+}
+
+func (f *feedbackStorage) CreateFeedbackRevision(_ context.Context, _ *domain.FeedbackRevision) error {
+	return nil
+}
+
+func (f *feedbackStorage) UpdateFeedback(_ context.Context, feedback *domain.Feedback) (*domain.Feedback, error) {
+	updated := *feedback
+	return &updated, nil
+}
+
+func (f *feedbackStorage) DeleteFeedbackScores(_ context.Context, _ string) error {
+	f.replacedScores = true
+	f.scores = nil
+	return nil
+}
+
+func (f *feedbackStorage) CreateFeedbackScores(_ context.Context, _ string, scores []domain.CriterionScore) error {
+	f.scores = scores
+	return nil
+}
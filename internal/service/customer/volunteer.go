@@ -0,0 +1,127 @@
+package customer
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/internal/service/pagination"
+	"DobrikaDev/customer-service/internal/storage/sql"
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+)
+
+// CreateVolunteerFeedback stores the review a customer leaves about the
+// volunteer who did the task. A customer reviews a volunteer once per task.
+func (s *CustomerService) CreateVolunteerFeedback(ctx context.Context, feedback *domain.VolunteerFeedback) (*domain.VolunteerFeedback, error) {
+	if feedback.Rating < 1 || feedback.Rating > 5 {
+		return nil, ErrFeedbackInvalid
+	}
+	if feedback.CustomerID == "" || feedback.UserID == "" || feedback.TaskID == "" {
+		return nil, ErrFeedbackInvalid
+	}
+	if feedback.CustomerID == feedback.UserID {
+		return nil, ErrFeedbackInvalid
+	}
+	if err := s.checkRateLimit(ctx, s.cfg.RateLimit.Feedback, "volunteer_feedback:"+feedback.CustomerID); err != nil {
+		return nil, err
+	}
+	if err := s.checkParticipation(ctx, feedback.TaskID, feedback.UserID, feedback.CustomerID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.contentFilter.Screen(ctx, feedback.Comment)
+	if err != nil {
+		s.log(ctx).Error("failed to screen volunteer feedback", zap.Error(err), zap.String("customer_id", feedback.CustomerID))
+		return nil, ErrFeedbackInternal
+	}
+	feedback.Comment = result.Text
+	feedback.Screening = result.Verdict
+	// volunteer reviews have no moderation queue, so whatever the filter
+	// wants a moderator to look at is refused as well
+	if result.Verdict != nil && (result.Verdict.Action == domain.ScreeningActionReject || result.Verdict.Action == domain.ScreeningActionModerate) {
+		return nil, ErrFeedbackRejected
+	}
+
+	created, err := s.storage.CreateVolunteerFeedback(ctx, feedback)
+	if err != nil {
+		if errors.Is(err, sql.ErrFeedbackAlreadyExists) {
+			return nil, ErrFeedbackAlreadyExists
+		}
+		if errors.Is(err, sql.ErrFeedbackInvalid) {
+			return nil, ErrFeedbackInvalid
+		}
+		s.log(ctx).Error("failed to create volunteer feedback", zap.Error(err), zap.Any("feedback", feedback))
+		return nil, ErrFeedbackInternal
+	}
+	return created, nil
+}
+
+func (s *CustomerService) GetVolunteerFeedbacks(ctx context.Context, filter *domain.VolunteerFeedbackFilter, page domain.Page) ([]*domain.VolunteerFeedback, int, string, error) {
+	opts := []sql.GetFeedbacksOptions{
+		sql.WithUserID(filter.UserID),
+		sql.WithCustomerID(filter.CustomerID),
+		sql.WithTaskID(filter.TaskID),
+		s.revealedVolunteerFeedbacks(),
+	}
+	if page.Token != "" {
+		cursor, err := s.pageTokens.Decode(page.Token)
+		if err != nil {
+			return nil, 0, "", ErrFeedbackInvalid
+		}
+		opts = append(opts, sql.WithFeedbacksAfter(cursor.CreatedAt, cursor.ID))
+	} else {
+		opts = append(opts, sql.WithOffset(page.Offset))
+	}
+	if page.Limit > 0 {
+		// one extra row tells whether there is a next page
+		opts = append(opts, sql.WithLimit(page.Limit+1))
+	}
+	feedbacks, count, err := s.storage.GetVolunteerFeedbacks(ctx, opts...)
+	if err != nil {
+		return nil, 0, "", ErrFeedbackInternal
+	}
+
+	nextPageToken := ""
+	if page.Limit > 0 && len(feedbacks) > page.Limit {
+		feedbacks = feedbacks[:page.Limit]
+		last := feedbacks[len(feedbacks)-1]
+		nextPageToken = s.pageTokens.Encode(pagination.Cursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+	return feedbacks, count, nextPageToken, nil
+}
+
+func (s *CustomerService) GetVolunteerFeedbackByID(ctx context.Context, id string) (*domain.VolunteerFeedback, error) {
+	feedbacks, _, err := s.storage.GetVolunteerFeedbacks(ctx, sql.WithFeedbackID(id), s.revealedVolunteerFeedbacks())
+	if err != nil {
+		return nil, ErrFeedbackInternal
+	}
+	if len(feedbacks) == 0 {
+		return nil, ErrFeedbackNotFound
+	}
+	return feedbacks[0], nil
+}
+
+// GetVolunteerRating sums up the revealed reviews of a volunteer.
+func (s *CustomerService) GetVolunteerRating(ctx context.Context, userID string) (*domain.VolunteerRating, error) {
+	rating, err := s.storage.GetVolunteerRating(ctx, userID, s.revealedVolunteerFeedbacks())
+	if err != nil {
+		return nil, ErrFeedbackInternal
+	}
+	return rating, nil
+}
+
+// revealedFeedbacks hides user reviews still under the blind period. It is nil
+// when blind review is off.
+func (s *CustomerService) revealedFeedbacks() sql.GetFeedbacksOptions {
+	if !s.cfg.Feedback.BlindReview {
+		return nil
+	}
+	return sql.WithRevealedFeedbacks(s.cfg.Feedback.RevealAfter)
+}
+
+func (s *CustomerService) revealedVolunteerFeedbacks() sql.GetFeedbacksOptions {
+	if !s.cfg.Feedback.BlindReview {
+		return nil
+	}
+	return sql.WithRevealedVolunteerFeedbacks(s.cfg.Feedback.RevealAfter)
+}
@@ -0,0 +1,180 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// ErrShuttingDown is reported by every check once Shutdown was called.
+var ErrShuttingDown = errors.New("shutting down")
+
+// Check reports whether a dependency is usable.
+type Check struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// Checker runs the checks periodically and publishes the results through the
+// standard gRPC health service: every check under its own name, and their
+// conjunction under the empty name and each of the given services.
+type Checker struct {
+	server   *health.Server
+	services []string
+	checks   []Check
+	interval time.Duration
+	timeout  time.Duration
+	logger   *zap.Logger
+
+	mu       sync.RWMutex
+	results  map[string]error
+	shutdown bool
+}
+
+// NewChecker starts with every service NOT_SERVING until the first run.
+func NewChecker(services []string, checks []Check, interval, timeout time.Duration, logger *zap.Logger) *Checker {
+	c := &Checker{
+		server:   health.NewServer(),
+		services: services,
+		checks:   checks,
+		interval: interval,
+		timeout:  timeout,
+		logger:   logger,
+		results:  make(map[string]error, len(checks)),
+	}
+	for _, check := range checks {
+		c.results[check.Name] = errors.New("not checked yet")
+	}
+	c.publish()
+	return c
+}
+
+// Server is the gRPC health service to register on the server.
+func (c *Checker) Server() healthpb.HealthServer {
+	return c.server
+}
+
+// Run checks the dependencies every interval. It blocks until ctx is
+// cancelled. A zero interval checks only once.
+func (c *Checker) Run(ctx context.Context) {
+	if c.interval <= 0 {
+		c.CheckNow(ctx)
+		return
+	}
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.CheckNow(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckNow runs all checks once and publishes their results.
+func (c *Checker) CheckNow(ctx context.Context) {
+	results := make(map[string]error, len(c.checks))
+	for _, check := range c.checks {
+		checkCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		err := check.Check(checkCtx)
+		cancel()
+		if err != nil {
+			c.logger.Warn("health check failed", zap.String("check", check.Name), zap.Error(err))
+		}
+		results[check.Name] = err
+	}
+
+	c.mu.Lock()
+	c.results = results
+	c.mu.Unlock()
+	c.publish()
+}
+
+// Results returns the latest result of every check by name, nil for passing
+// ones.
+func (c *Checker) Results() map[string]error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	results := make(map[string]error, len(c.results))
+	for name, err := range c.results {
+		if c.shutdown {
+			err = ErrShuttingDown
+		}
+		results[name] = err
+	}
+	return results
+}
+
+// Ready reports whether all checks passed and no shutdown is in progress.
+func (c *Checker) Ready() bool {
+	for _, err := range c.Results() {
+		if err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Shutdown turns every service NOT_SERVING for good, so that clients and
+// probes stop sending new calls while the server drains.
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	c.shutdown = true
+	c.mu.Unlock()
+	c.server.Shutdown()
+}
+
+func (c *Checker) publish() {
+	ready := true
+	for name, err := range c.Results() {
+		c.server.SetServingStatus(name, servingStatus(err == nil))
+		ready = ready && err == nil
+	}
+	c.server.SetServingStatus("", servingStatus(ready))
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, servingStatus(ready))
+	}
+}
+
+func servingStatus(ok bool) healthpb.HealthCheckResponse_ServingStatus {
+	if ok {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
+
+// PingCheck checks that the database answers.
+func PingCheck(db interface{ PingContext(context.Context) error }) Check {
+	return Check{Name: "postgres", Check: db.PingContext}
+}
+
+type versioner interface {
+	GetVersions(ctx context.Context) (current, target int64, err error)
+}
+
+// MigrationCheck checks that the database schema has at least the latest
+// embedded migration. A newer schema is fine: during a rollout the migrator
+// runs before the old replicas are replaced.
+func MigrationCheck(migrations versioner) Check {
+	return Check{Name: "migrations", Check: func(ctx context.Context) error {
+		current, target, err := migrations.GetVersions(ctx)
+		if err != nil {
+			return err
+		}
+		if current < target {
+			return fmt.Errorf("database at version %d, want %d", current, target)
+		}
+		return nil
+	}}
+}
@@ -0,0 +1,66 @@
+package pagination
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSignerEmptySecret(t *testing.T) {
+	if _, err := NewSigner(""); !errors.Is(err, ErrEmptySecret) {
+		t.Fatalf("NewSigner(\"\") error = %v, want %v", err, ErrEmptySecret)
+	}
+}
+
+func TestSignerRoundTrip(t *testing.T) {
+	signer := mustSigner(t, "secret")
+	cursor := Cursor{CreatedAt: time.Date(2025, 11, 20, 12, 30, 0, 0, time.UTC), ID: "feedback-1"}
+
+	decoded, err := signer.Decode(signer.Encode(cursor))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !decoded.CreatedAt.Equal(cursor.CreatedAt) || decoded.ID != cursor.ID {
+		t.Errorf("Decode() = %+v, want %+v", decoded, cursor)
+	}
+}
+
+func TestSignerDecodeInvalid(t *testing.T) {
+	signer := mustSigner(t, "secret")
+	token := signer.Encode(Cursor{CreatedAt: time.Now(), ID: "feedback-1"})
+	payload, signature, _ := strings.Cut(token, ".")
+	forged := mustSigner(t, "other").Encode(Cursor{CreatedAt: time.Now(), ID: "feedback-2"})
+	forgedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "no signature", token: payload},
+		{name: "payload not base64", token: "!!!." + signature},
+		{name: "signature not base64", token: payload + ".!!!"},
+		{name: "other secret", token: forged},
+		{name: "swapped payload", token: forgedPayload + "." + signature},
+		{name: "signed garbage", token: encode([]byte("null")) + "." + encode(signer.sign([]byte("null")))},
+		{name: "signed cursor without id", token: signer.Encode(Cursor{CreatedAt: time.Now()})},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := signer.Decode(tt.token); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Decode(%q) error = %v, want %v", tt.token, err, ErrInvalidToken)
+			}
+		})
+	}
+}
+
+func mustSigner(t *testing.T, secret string) *Signer {
+	t.Helper()
+	signer, err := NewSigner(secret)
+	if err != nil {
+		t.Fatalf("NewSigner() error = %v", err)
+	}
+	return signer
+}
@@ -0,0 +1,20 @@
+package ratelimit
+
+import "context"
+
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx carrying the identity of the calling
+// client, as told apart by the rate limit interceptor.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the caller identity of ctx, or fallback when the
+// call did not pass the interceptor.
+func CallerFromContext(ctx context.Context, fallback string) string {
+	if caller, ok := ctx.Value(callerKey{}).(string); ok && caller != "" {
+		return caller
+	}
+	return fallback
+}
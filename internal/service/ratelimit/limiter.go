@@ -0,0 +1,41 @@
+package ratelimit
+
+import (
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"math"
+	"time"
+)
+
+// Decision is the outcome of taking a token. RetryAfter tells a refused
+// caller when the next token is available.
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// Limiter keeps one token bucket per key. A bucket with a zero limit or
+// period lets everything through.
+type Limiter interface {
+	Allow(ctx context.Context, key string, bucket config.RateLimitBucket) (Decision, error)
+}
+
+func enabled(bucket config.RateLimitBucket) bool {
+	return bucket.Limit > 0 && bucket.Period > 0
+}
+
+// take refills a bucket that held tokens elapsed ago and takes one token from
+// it. It returns the tokens left in the bucket.
+func take(tokens float64, elapsed time.Duration, bucket config.RateLimitBucket) (float64, Decision) {
+	capacity := float64(bucket.Limit)
+	rate := capacity / bucket.Period.Seconds()
+	// replicas and lock waiters may see a slightly older clock
+	elapsed = max(elapsed, 0)
+
+	tokens = math.Min(capacity, tokens+elapsed.Seconds()*rate)
+	if tokens >= 1 {
+		return tokens - 1, Decision{Allowed: true}
+	}
+	wait := time.Duration((1 - tokens) / rate * float64(time.Second))
+	return tokens, Decision{RetryAfter: wait}
+}
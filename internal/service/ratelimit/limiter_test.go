@@ -0,0 +1,101 @@
+package ratelimit
+
+import (
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEnabled(t *testing.T) {
+	tests := []struct {
+		name   string
+		bucket config.RateLimitBucket
+		want   bool
+	}{
+		{name: "zero", bucket: config.RateLimitBucket{}, want: false},
+		{name: "no period", bucket: config.RateLimitBucket{Limit: 5}, want: false},
+		{name: "no limit", bucket: config.RateLimitBucket{Period: time.Minute}, want: false},
+		{name: "negative limit", bucket: config.RateLimitBucket{Limit: -1, Period: time.Minute}, want: false},
+		{name: "limit and period", bucket: config.RateLimitBucket{Limit: 5, Period: time.Minute}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := enabled(tt.bucket); got != tt.want {
+				t.Errorf("enabled(%+v) = %v, want %v", tt.bucket, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTake(t *testing.T) {
+	// one token per second
+	bucket := config.RateLimitBucket{Limit: 10, Period: 10 * time.Second}
+
+	tests := []struct {
+		name       string
+		tokens     float64
+		elapsed    time.Duration
+		wantTokens float64
+		wantAllow  bool
+		wantRetry  time.Duration
+	}{
+		{name: "full bucket", tokens: 10, elapsed: 0, wantTokens: 9, wantAllow: true},
+		{name: "last token", tokens: 1, elapsed: 0, wantTokens: 0, wantAllow: true},
+		{name: "empty bucket", tokens: 0, elapsed: 0, wantTokens: 0, wantRetry: time.Second},
+		{name: "half a token", tokens: 0.5, elapsed: 0, wantTokens: 0.5, wantRetry: 500 * time.Millisecond},
+		{name: "refilled", tokens: 0, elapsed: 3 * time.Second, wantTokens: 2, wantAllow: true},
+		{name: "refill capped at limit", tokens: 5, elapsed: time.Hour, wantTokens: 9, wantAllow: true},
+		{name: "clock behind", tokens: 0.5, elapsed: -5 * time.Second, wantTokens: 0.5, wantRetry: 500 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, decision := take(tt.tokens, tt.elapsed, bucket)
+			if math.Abs(tokens-tt.wantTokens) > 1e-9 {
+				t.Errorf("take() tokens = %v, want %v", tokens, tt.wantTokens)
+			}
+			if decision.Allowed != tt.wantAllow {
+				t.Errorf("take() allowed = %v, want %v", decision.Allowed, tt.wantAllow)
+			}
+			if decision.RetryAfter != tt.wantRetry {
+				t.Errorf("take() retry after = %v, want %v", decision.RetryAfter, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestMemoryLimiterAllow(t *testing.T) {
+	ctx := context.Background()
+	bucket := config.RateLimitBucket{Limit: 2, Period: time.Hour}
+	limiter := NewMemoryLimiter(time.Hour)
+
+	tests := []struct {
+		name      string
+		key       string
+		bucket    config.RateLimitBucket
+		wantAllow bool
+	}{
+		{name: "first token", key: "a", bucket: bucket, wantAllow: true},
+		{name: "second token", key: "a", bucket: bucket, wantAllow: true},
+		{name: "bucket empty", key: "a", bucket: bucket, wantAllow: false},
+		{name: "other key", key: "b", bucket: bucket, wantAllow: true},
+		{name: "disabled bucket", key: "a", bucket: config.RateLimitBucket{}, wantAllow: true},
+	}
+
+	// the cases share the limiter and run in order
+	for _, tt := range tests {
+		decision, err := limiter.Allow(ctx, tt.key, tt.bucket)
+		if err != nil {
+			t.Fatalf("%s: Allow() error = %v", tt.name, err)
+		}
+		if decision.Allowed != tt.wantAllow {
+			t.Errorf("%s: Allow() allowed = %v, want %v", tt.name, decision.Allowed, tt.wantAllow)
+		}
+		if !decision.Allowed && (decision.RetryAfter <= 0 || decision.RetryAfter > bucket.Period/2) {
+			t.Errorf("%s: Allow() retry after = %v, want within (0, %v]", tt.name, decision.RetryAfter, bucket.Period/2)
+		}
+	}
+}
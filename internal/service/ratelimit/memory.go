@@ -0,0 +1,56 @@
+package ratelimit
+
+import (
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryLimiter keeps the buckets in process. Every replica counts on its
+// own, so the effective limit grows with the number of replicas.
+type MemoryLimiter struct {
+	idle time.Duration
+
+	mu        sync.Mutex
+	buckets   map[string]*memoryBucket
+	lastSweep time.Time
+}
+
+type memoryBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// NewMemoryLimiter drops buckets left untouched for idle. Idle should be at
+// least the longest period in use, by then a bucket is full again anyway.
+func NewMemoryLimiter(idle time.Duration) *MemoryLimiter {
+	return &MemoryLimiter{idle: idle, buckets: make(map[string]*memoryBucket)}
+}
+
+func (l *MemoryLimiter) Allow(_ context.Context, key string, bucket config.RateLimitBucket) (Decision, error) {
+	if !enabled(bucket) {
+		return Decision{Allowed: true}, nil
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.idle > 0 && now.Sub(l.lastSweep) >= l.idle {
+		for k, b := range l.buckets {
+			if now.Sub(b.updatedAt) >= l.idle {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &memoryBucket{tokens: float64(bucket.Limit), updatedAt: now}
+		l.buckets[key] = b
+	}
+	tokens, decision := take(b.tokens, now.Sub(b.updatedAt), bucket)
+	b.tokens, b.updatedAt = tokens, now
+	return decision, nil
+}
@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"DobrikaDev/customer-service/utils/config"
+	"DobrikaDev/customer-service/utils/logger"
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+type bucketStorage interface {
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+
+	LockRateLimitBucket(ctx context.Context, key string, tokens float64) (float64, time.Duration, error)
+	SaveRateLimitBucket(ctx context.Context, key string, tokens float64) error
+	DeleteIdleRateLimitBuckets(ctx context.Context, idle time.Duration) error
+}
+
+// PostgresLimiter keeps the buckets in a table shared by all replicas. A
+// bucket row is locked while its tokens are taken, so concurrent requests for
+// the same key are counted one after another.
+type PostgresLimiter struct {
+	storage bucketStorage
+	idle    time.Duration
+	logger  *zap.Logger
+
+	mu        sync.Mutex
+	lastSweep time.Time
+}
+
+// NewPostgresLimiter deletes buckets left untouched for idle, see
+// NewMemoryLimiter.
+func NewPostgresLimiter(storage bucketStorage, idle time.Duration, logger *zap.Logger) *PostgresLimiter {
+	return &PostgresLimiter{storage: storage, idle: idle, logger: logger}
+}
+
+func (l *PostgresLimiter) Allow(ctx context.Context, key string, bucket config.RateLimitBucket) (Decision, error) {
+	if !enabled(bucket) {
+		return Decision{Allowed: true}, nil
+	}
+
+	var decision Decision
+	err := l.storage.Do(ctx, func(ctx context.Context) error {
+		tokens, elapsed, err := l.storage.LockRateLimitBucket(ctx, key, float64(bucket.Limit))
+		if err != nil {
+			return err
+		}
+		tokens, decision = take(tokens, elapsed, bucket)
+		return l.storage.SaveRateLimitBucket(ctx, key, tokens)
+	})
+	if err != nil {
+		return Decision{}, err
+	}
+
+	l.sweep(ctx)
+	return decision, nil
+}
+
+// sweep deletes idle buckets at most once per idle period on each replica.
+func (l *PostgresLimiter) sweep(ctx context.Context) {
+	if l.idle <= 0 {
+		return
+	}
+	now := time.Now()
+	l.mu.Lock()
+	if now.Sub(l.lastSweep) < l.idle {
+		l.mu.Unlock()
+		return
+	}
+	l.lastSweep = now
+	l.mu.Unlock()
+
+	if err := l.storage.DeleteIdleRateLimitBuckets(ctx, l.idle); err != nil {
+		l.log(ctx).Warn("failed to delete idle rate limit buckets", zap.Error(err))
+	}
+}
+
+// log returns the request-scoped logger of ctx.
+func (l *PostgresLimiter) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, l.logger)
+}
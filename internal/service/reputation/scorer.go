@@ -0,0 +1,44 @@
+package reputation
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/utils/config"
+	"time"
+)
+
+// Scorer computes a Bayesian average of customer ratings: every customer starts
+// with PriorWeight virtual ratings of PriorMean, so a handful of reviews cannot
+// outrank a long track record. With a non-zero HalfLife older ratings lose
+// half of their weight every HalfLife; the decayed sums are computed by the
+// storage layer and passed in as a domain.WeightedRating.
+type Scorer struct {
+	cfg config.Reputation
+}
+
+func NewScorer(cfg config.Reputation) *Scorer {
+	return &Scorer{cfg: cfg}
+}
+
+func (s *Scorer) PriorMean() float64 {
+	return s.cfg.PriorMean
+}
+
+func (s *Scorer) PriorWeight() float64 {
+	return s.cfg.PriorWeight
+}
+
+func (s *Scorer) HalfLife() time.Duration {
+	return s.cfg.HalfLife
+}
+
+func (s *Scorer) Score(rating *domain.WeightedRating) float64 {
+	var sum, weight float64
+	if rating != nil {
+		sum, weight = rating.Sum, rating.Weight
+	}
+	total := s.cfg.PriorWeight + weight
+	if total <= 0 {
+		return 0
+	}
+	return (s.cfg.PriorWeight*s.cfg.PriorMean + sum) / total
+}
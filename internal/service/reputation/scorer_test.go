@@ -0,0 +1,36 @@
+package reputation
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/utils/config"
+	"math"
+	"testing"
+)
+
+func TestScorerScore(t *testing.T) {
+	prior := config.Reputation{PriorMean: 4, PriorWeight: 10}
+
+	tests := []struct {
+		name   string
+		cfg    config.Reputation
+		rating *domain.WeightedRating
+		want   float64
+	}{
+		{name: "no rating", cfg: prior, rating: nil, want: 4},
+		{name: "no weight", cfg: prior, rating: &domain.WeightedRating{}, want: 4},
+		{name: "few top ratings", cfg: prior, rating: &domain.WeightedRating{Sum: 10, Weight: 2}, want: 50.0 / 12},
+		{name: "prior outweighed", cfg: prior, rating: &domain.WeightedRating{Sum: 50, Weight: 10}, want: 4.5},
+		{name: "long track record", cfg: prior, rating: &domain.WeightedRating{Sum: 2000, Weight: 1000}, want: 2040.0 / 1010},
+		{name: "decayed weights", cfg: prior, rating: &domain.WeightedRating{Sum: 7.5, Weight: 1.5}, want: 47.5 / 11.5},
+		{name: "no prior", cfg: config.Reputation{}, rating: &domain.WeightedRating{Sum: 9, Weight: 2}, want: 4.5},
+		{name: "no prior and no rating", cfg: config.Reputation{}, rating: nil, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NewScorer(tt.cfg).Score(tt.rating); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Score() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
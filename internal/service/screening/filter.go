@@ -0,0 +1,18 @@
+package screening
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+)
+
+// ContentFilter checks user supplied text before it is stored. Text holds the
+// text to persist, with offending fragments masked when the verdict asks for
+// it. A nil Verdict means the text was not screened at all.
+type ContentFilter interface {
+	Screen(ctx context.Context, text string) (*Result, error)
+}
+
+type Result struct {
+	Text    string
+	Verdict *domain.ScreeningVerdict
+}
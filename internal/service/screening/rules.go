@@ -0,0 +1,198 @@
+package screening
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	wordPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
+	contactPatterns = []*regexp.Regexp{
+		// Russian phone numbers: +7 (916) 123-45-67, 89161234567, 916 123 45 67.
+		regexp.MustCompile(`(?:\+7|8)?[\s\-(]*\d{3}[\s\-)]*\d{3}[\s\-]*\d{2}[\s\-]*\d{2}`),
+		regexp.MustCompile(`[\p{L}\p{N}._%+\-]+@[\p{L}\p{N}\-]+(?:\.[\p{L}\p{N}\-]+)+`),
+		// Messenger handles such as @username.
+		regexp.MustCompile(`@[A-Za-z0-9_]{5,32}`),
+	}
+
+	linkPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)(?:https?://|www\.)\S+`),
+		regexp.MustCompile(`(?i)(?:[\p{L}\p{N}\-]+\.)+(?:ru|рф|su|com|net|org|info|biz|me|io|online|site|xyz|club|shop|pro)(?:/\S*)?`),
+	}
+)
+
+// homoglyphs maps Latin letters that look like Cyrillic ones, so "cyka" and
+// "сука" are the same word to the rule engine.
+var homoglyphs = strings.NewReplacer(
+	"a", "а", "b", "в", "c", "с", "e", "е", "h", "н", "k", "к", "m", "м",
+	"o", "о", "p", "р", "t", "т", "x", "х", "y", "у", "ё", "е",
+)
+
+type span struct {
+	start, end int
+}
+
+// RuleEngine is the built-in ContentFilter. Obscene and spam words are
+// configured as stems and match every word that starts with the stem, either
+// directly or after one of the configured prefixes, which covers Russian
+// inflections and prefixed forms. Phone numbers, e-mails and messenger handles
+// are reported as contacts, URLs and bare domains as links. Each category has
+// its own action; a category with an empty action is not checked.
+type RuleEngine struct {
+	enabled      bool
+	obsceneWords []string
+	spamWords    []string
+	prefixes     []string
+	actions      map[domain.ScreeningCategory]domain.ScreeningAction
+}
+
+func NewRuleEngine(cfg config.Screening) (*RuleEngine, error) {
+	engine := &RuleEngine{
+		enabled:      cfg.Enabled,
+		obsceneWords: normalizeWords(cfg.ObsceneWords),
+		spamWords:    normalizeWords(cfg.SpamWords),
+		prefixes:     normalizeWords(cfg.Prefixes),
+		actions:      make(map[domain.ScreeningCategory]domain.ScreeningAction),
+	}
+
+	for category, action := range map[domain.ScreeningCategory]string{
+		domain.ScreeningCategoryObscene: cfg.ObsceneAction,
+		domain.ScreeningCategoryContact: cfg.ContactAction,
+		domain.ScreeningCategoryLink:    cfg.LinkAction,
+		domain.ScreeningCategorySpam:    cfg.SpamAction,
+	} {
+		switch domain.ScreeningAction(action) {
+		case "", domain.ScreeningActionAllow:
+		case domain.ScreeningActionMask, domain.ScreeningActionModerate, domain.ScreeningActionReject:
+			engine.actions[category] = domain.ScreeningAction(action)
+		default:
+			return nil, fmt.Errorf("unknown screening action %q for %s", action, category)
+		}
+	}
+
+	return engine, nil
+}
+
+func (e *RuleEngine) Screen(ctx context.Context, text string) (*Result, error) {
+	if !e.enabled {
+		return &Result{Text: text}, nil
+	}
+
+	verdict := &domain.ScreeningVerdict{Action: domain.ScreeningActionAllow}
+	var masked []span
+	for category, spans := range e.match(text) {
+		if len(spans) == 0 {
+			continue
+		}
+		action := e.actions[category]
+		verdict = verdict.Merge(&domain.ScreeningVerdict{
+			Action:     action,
+			Categories: []domain.ScreeningCategory{category},
+		})
+		if action == domain.ScreeningActionMask {
+			masked = append(masked, spans...)
+		}
+	}
+	slices.Sort(verdict.Categories)
+
+	return &Result{Text: mask(text, masked), Verdict: verdict}, nil
+}
+
+func (e *RuleEngine) match(text string) map[domain.ScreeningCategory][]span {
+	matches := make(map[domain.ScreeningCategory][]span, len(e.actions))
+
+	checkWords := e.actions[domain.ScreeningCategoryObscene] != "" || e.actions[domain.ScreeningCategorySpam] != ""
+	if checkWords {
+		for _, loc := range wordPattern.FindAllStringIndex(text, -1) {
+			word := normalizeWord(text[loc[0]:loc[1]])
+			if e.actions[domain.ScreeningCategoryObscene] != "" && e.hasStem(word, e.obsceneWords) {
+				matches[domain.ScreeningCategoryObscene] = append(matches[domain.ScreeningCategoryObscene], span{loc[0], loc[1]})
+			}
+			if e.actions[domain.ScreeningCategorySpam] != "" && e.hasStem(word, e.spamWords) {
+				matches[domain.ScreeningCategorySpam] = append(matches[domain.ScreeningCategorySpam], span{loc[0], loc[1]})
+			}
+		}
+	}
+	if e.actions[domain.ScreeningCategoryContact] != "" {
+		matches[domain.ScreeningCategoryContact] = findAll(text, contactPatterns)
+	}
+	if e.actions[domain.ScreeningCategoryLink] != "" {
+		matches[domain.ScreeningCategoryLink] = findAll(text, linkPatterns)
+	}
+
+	return matches
+}
+
+func (e *RuleEngine) hasStem(word string, stems []string) bool {
+	for _, stem := range stems {
+		if strings.HasPrefix(word, stem) {
+			return true
+		}
+		for _, prefix := range e.prefixes {
+			if strings.HasPrefix(word, prefix) && strings.HasPrefix(word[len(prefix):], stem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// findAll returns the matches of every pattern that end on a word boundary, so
+// that "site.community" is not taken for "site.com".
+func findAll(text string, patterns []*regexp.Regexp) []span {
+	var spans []span
+	for _, pattern := range patterns {
+		for _, loc := range pattern.FindAllStringIndex(text, -1) {
+			next, _ := utf8.DecodeRuneInString(text[loc[1]:])
+			if unicode.IsLetter(next) || unicode.IsDigit(next) {
+				continue
+			}
+			spans = append(spans, span{loc[0], loc[1]})
+		}
+	}
+	return spans
+}
+
+// mask replaces every rune inside the spans with an asterisk.
+func mask(text string, spans []span) string {
+	if len(spans) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	b.Grow(len(text))
+	for i, r := range text {
+		masked := slices.ContainsFunc(spans, func(s span) bool {
+			return i >= s.start && i < s.end
+		})
+		if masked && !unicode.IsSpace(r) {
+			b.WriteRune('*')
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func normalizeWord(word string) string {
+	return homoglyphs.Replace(strings.ToLower(word))
+}
+
+func normalizeWords(words []string) []string {
+	normalized := make([]string, 0, len(words))
+	for _, word := range words {
+		word = normalizeWord(strings.TrimSpace(word))
+		if word != "" && !slices.Contains(normalized, word) {
+			normalized = append(normalized, word)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,161 @@
+package screening
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"DobrikaDev/customer-service/utils/config"
+	"context"
+	"slices"
+	"testing"
+)
+
+func TestRuleEngineScreen(t *testing.T) {
+	base := config.Screening{
+		Enabled:      true,
+		ObsceneWords: []string{"дурак", "глуп"},
+		SpamWords:    []string{"скидк"},
+		Prefixes:     []string{"по"},
+	}
+	with := func(change func(cfg *config.Screening)) config.Screening {
+		cfg := base
+		change(&cfg)
+		return cfg
+	}
+
+	tests := []struct {
+		name       string
+		cfg        config.Screening
+		text       string
+		wantText   string
+		wantAction domain.ScreeningAction
+		wantCats   []domain.ScreeningCategory
+		unscreened bool
+	}{
+		{
+			name:       "disabled",
+			cfg:        with(func(cfg *config.Screening) { cfg.Enabled = false; cfg.ObsceneAction = "reject" }),
+			text:       "ты дурак",
+			wantText:   "ты дурак",
+			unscreened: true,
+		},
+		{
+			name:       "clean text",
+			cfg:        with(func(cfg *config.Screening) { cfg.ObsceneAction = "reject" }),
+			text:       "спасибо за помощь",
+			wantText:   "спасибо за помощь",
+			wantAction: domain.ScreeningActionAllow,
+		},
+		{
+			name:       "obscene word masked",
+			cfg:        with(func(cfg *config.Screening) { cfg.ObsceneAction = "mask" }),
+			text:       "ты дурак!",
+			wantText:   "ты *****!",
+			wantAction: domain.ScreeningActionMask,
+			wantCats:   []domain.ScreeningCategory{domain.ScreeningCategoryObscene},
+		},
+		{
+			name:       "inflected and prefixed stems",
+			cfg:        with(func(cfg *config.Screening) { cfg.ObsceneAction = "mask" }),
+			text:       "Дураки поглупели",
+			wantText:   "****** *********",
+			wantAction: domain.ScreeningActionMask,
+			wantCats:   []domain.ScreeningCategory{domain.ScreeningCategoryObscene},
+		},
+		{
+			name:       "latin homoglyphs",
+			cfg:        with(func(cfg *config.Screening) { cfg.ObsceneAction = "reject" }),
+			text:       "ты дypak",
+			wantText:   "ты дypak",
+			wantAction: domain.ScreeningActionReject,
+			wantCats:   []domain.ScreeningCategory{domain.ScreeningCategoryObscene},
+		},
+		{
+			name:       "category without action is not checked",
+			cfg:        with(func(cfg *config.Screening) { cfg.SpamAction = "reject" }),
+			text:       "ты дурак",
+			wantText:   "ты дурак",
+			wantAction: domain.ScreeningActionAllow,
+		},
+		{
+			name:       "phone number",
+			cfg:        with(func(cfg *config.Screening) { cfg.ContactAction = "reject" }),
+			text:       "звоните +7 (916) 123-45-67",
+			wantText:   "звоните +7 (916) 123-45-67",
+			wantAction: domain.ScreeningActionReject,
+			wantCats:   []domain.ScreeningCategory{domain.ScreeningCategoryContact},
+		},
+		{
+			name:       "e-mail masked",
+			cfg:        with(func(cfg *config.Screening) { cfg.ContactAction = "mask" }),
+			text:       "пишите ivan@mail.ru",
+			wantText:   "пишите ************",
+			wantAction: domain.ScreeningActionMask,
+			wantCats:   []domain.ScreeningCategory{domain.ScreeningCategoryContact},
+		},
+		{
+			name:       "link",
+			cfg:        with(func(cfg *config.Screening) { cfg.LinkAction = "moderate" }),
+			text:       "подробнее на www.example.org",
+			wantText:   "подробнее на www.example.org",
+			wantAction: domain.ScreeningActionModerate,
+			wantCats:   []domain.ScreeningCategory{domain.ScreeningCategoryLink},
+		},
+		{
+			name:       "domain prefix of a longer word",
+			cfg:        with(func(cfg *config.Screening) { cfg.LinkAction = "moderate" }),
+			text:       "site.community",
+			wantText:   "site.community",
+			wantAction: domain.ScreeningActionAllow,
+		},
+		{
+			name: "strictest action wins",
+			cfg: with(func(cfg *config.Screening) {
+				cfg.ObsceneAction = "mask"
+				cfg.SpamAction = "moderate"
+			}),
+			text:       "дурак, скидки",
+			wantText:   "*****, скидки",
+			wantAction: domain.ScreeningActionModerate,
+			wantCats:   []domain.ScreeningCategory{domain.ScreeningCategoryObscene, domain.ScreeningCategorySpam},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, err := NewRuleEngine(tt.cfg)
+			if err != nil {
+				t.Fatalf("NewRuleEngine() error = %v", err)
+			}
+			result, err := engine.Screen(context.Background(), tt.text)
+			if err != nil {
+				t.Fatalf("Screen() error = %v", err)
+			}
+			if result.Text != tt.wantText {
+				t.Errorf("Screen() text = %q, want %q", result.Text, tt.wantText)
+			}
+			if tt.unscreened {
+				if result.Verdict != nil {
+					t.Errorf("Screen() verdict = %+v, want nil", result.Verdict)
+				}
+				return
+			}
+			if result.Verdict == nil {
+				t.Fatal("Screen() verdict = nil")
+			}
+			if result.Verdict.Action != tt.wantAction {
+				t.Errorf("Screen() action = %q, want %q", result.Verdict.Action, tt.wantAction)
+			}
+			want := slices.Clone(tt.wantCats)
+			slices.Sort(want)
+			if !slices.Equal(result.Verdict.Categories, want) {
+				t.Errorf("Screen() categories = %v, want %v", result.Verdict.Categories, want)
+			}
+		})
+	}
+}
+
+func TestNewRuleEngineUnknownAction(t *testing.T) {
+	_, err := NewRuleEngine(config.Screening{Enabled: true, LinkAction: "block"})
+	if err == nil {
+		t.Fatal("NewRuleEngine() error = nil, want unknown action error")
+	}
+}
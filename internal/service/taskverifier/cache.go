@@ -0,0 +1,59 @@
+package taskverifier
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CachedVerifier remembers confirmed participations of another TaskVerifier
+// for ttl. Refusals and errors are never cached, so that a user who has just
+// finished a task can leave feedback right away.
+type CachedVerifier struct {
+	next TaskVerifier
+	ttl  time.Duration
+	now  func() time.Time
+
+	mu        sync.Mutex
+	entries   map[participation]cacheEntry
+	lastSweep time.Time
+}
+
+type cacheEntry struct {
+	expiresAt time.Time
+}
+
+func NewCachedVerifier(next TaskVerifier, ttl time.Duration) *CachedVerifier {
+	return &CachedVerifier{next: next, ttl: ttl, now: time.Now, entries: make(map[participation]cacheEntry)}
+}
+
+func (v *CachedVerifier) VerifyParticipation(ctx context.Context, taskID string, userID string, customerID string) (bool, error) {
+	key := participation{taskID, userID, customerID}
+	now := v.now()
+
+	v.mu.Lock()
+	entry, ok := v.entries[key]
+	v.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return true, nil
+	}
+
+	participated, err := v.next.VerifyParticipation(ctx, taskID, userID, customerID)
+	if err != nil || !participated {
+		return participated, err
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	// drop expired entries once per ttl so the map does not grow without bound
+	if now.Sub(v.lastSweep) >= v.ttl {
+		for k, e := range v.entries {
+			if !now.Before(e.expiresAt) {
+				delete(v.entries, k)
+			}
+		}
+		v.lastSweep = now
+	}
+	v.entries[key] = cacheEntry{expiresAt: now.Add(v.ttl)}
+	return true, nil
+}
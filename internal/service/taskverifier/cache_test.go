@@ -0,0 +1,63 @@
+package taskverifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// countingVerifier counts the calls that reach the wrapped verifier.
+type countingVerifier struct {
+	*MemoryVerifier
+	calls int
+}
+
+func (v *countingVerifier) VerifyParticipation(ctx context.Context, taskID string, userID string, customerID string) (bool, error) {
+	v.calls++
+	return v.MemoryVerifier.VerifyParticipation(ctx, taskID, userID, customerID)
+}
+
+func TestCachedVerifierVerifyParticipation(t *testing.T) {
+	ctx := context.Background()
+	next := &countingVerifier{MemoryVerifier: NewMemoryVerifier()}
+	next.AddParticipation("task-1", "user-1", "customer-1")
+	now := time.Date(2025, 11, 20, 12, 0, 0, 0, time.UTC)
+	cached := NewCachedVerifier(next, time.Minute)
+	cached.now = func() time.Time { return now }
+
+	tests := []struct {
+		name      string
+		taskID    string
+		advance   time.Duration
+		nextErr   error
+		want      bool
+		wantErr   error
+		wantCalls int
+	}{
+		{name: "first confirmation", taskID: "task-1", want: true, wantCalls: 1},
+		{name: "confirmation cached", taskID: "task-1", advance: 30 * time.Second, want: true, wantCalls: 1},
+		{name: "cached through outage", taskID: "task-1", advance: 20 * time.Second, nextErr: ErrUnavailable, want: true, wantCalls: 1},
+		{name: "expired entry asks again", taskID: "task-1", advance: 10 * time.Second, want: true, wantCalls: 2},
+		{name: "refusal", taskID: "task-2", want: false, wantCalls: 3},
+		{name: "refusal not cached", taskID: "task-2", want: false, wantCalls: 4},
+		{name: "error", taskID: "task-3", nextErr: ErrUnavailable, wantErr: ErrUnavailable, wantCalls: 5},
+		{name: "error not cached", taskID: "task-3", nextErr: ErrUnavailable, wantErr: ErrUnavailable, wantCalls: 6},
+	}
+
+	// the cases share the cache and run in order
+	for _, tt := range tests {
+		now = now.Add(tt.advance)
+		next.SetError(tt.nextErr)
+		got, err := cached.VerifyParticipation(ctx, tt.taskID, "user-1", "customer-1")
+		if !errors.Is(err, tt.wantErr) {
+			t.Fatalf("%s: VerifyParticipation() error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("%s: VerifyParticipation() = %v, want %v", tt.name, got, tt.want)
+		}
+		if next.calls != tt.wantCalls {
+			t.Errorf("%s: task service calls = %d, want %d", tt.name, next.calls, tt.wantCalls)
+		}
+	}
+}
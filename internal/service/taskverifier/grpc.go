@@ -0,0 +1,57 @@
+package taskverifier
+
+import (
+	taskpb "DobrikaDev/customer-service/internal/generated/proto/task"
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCVerifier asks the task service over gRPC.
+type GRPCVerifier struct {
+	conn    *grpc.ClientConn
+	client  taskpb.TaskServiceClient
+	timeout time.Duration
+}
+
+func NewGRPCVerifier(address string, timeout time.Duration) (*GRPCVerifier, error) {
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task service client: %w", err)
+	}
+	return &GRPCVerifier{conn: conn, client: taskpb.NewTaskServiceClient(conn), timeout: timeout}, nil
+}
+
+func (v *GRPCVerifier) VerifyParticipation(ctx context.Context, taskID string, userID string, customerID string) (bool, error) {
+	if v.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.timeout)
+		defer cancel()
+	}
+	resp, err := v.client.VerifyParticipation(ctx, &taskpb.VerifyParticipationRequest{
+		TaskId:     taskID,
+		UserId:     userID,
+		CustomerId: customerID,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded:
+			return false, fmt.Errorf("%w: %w", ErrUnavailable, err)
+		case codes.NotFound, codes.InvalidArgument, codes.PermissionDenied, codes.FailedPrecondition:
+			// the task service knows of no such participation
+			return false, nil
+		default:
+			return false, fmt.Errorf("%w: %w", ErrFailed, err)
+		}
+	}
+	return resp.Participated, nil
+}
+
+func (v *GRPCVerifier) Close() error {
+	return v.conn.Close()
+}
@@ -0,0 +1,53 @@
+package taskverifier
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryVerifier is an in-memory TaskVerifier for tests and local runs. It
+// knows only the participations added to it; with AllowAll it accepts every
+// one.
+type MemoryVerifier struct {
+	mu             sync.RWMutex
+	allowAll       bool
+	err            error
+	participations map[participation]bool
+}
+
+type participation struct {
+	taskID, userID, customerID string
+}
+
+func NewMemoryVerifier() *MemoryVerifier {
+	return &MemoryVerifier{participations: make(map[participation]bool)}
+}
+
+// NewAllowAllVerifier accepts every participation. It stands in for the task
+// service on local runs with task.allow_all set.
+func NewAllowAllVerifier() *MemoryVerifier {
+	return &MemoryVerifier{allowAll: true, participations: make(map[participation]bool)}
+}
+
+func (v *MemoryVerifier) AddParticipation(taskID string, userID string, customerID string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.participations[participation{taskID, userID, customerID}] = true
+}
+
+// SetError makes every following call fail with err, e.g. ErrUnavailable to
+// simulate an outage. A nil err restores normal answers.
+func (v *MemoryVerifier) SetError(err error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.err = err
+}
+
+func (v *MemoryVerifier) VerifyParticipation(ctx context.Context, taskID string, userID string, customerID string) (bool, error) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if v.err != nil {
+		return false, v.err
+	}
+	return v.allowAll || v.participations[participation{taskID, userID, customerID}], nil
+}
@@ -0,0 +1,47 @@
+package taskverifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestMemoryVerifierVerifyParticipation(t *testing.T) {
+	known := NewMemoryVerifier()
+	known.AddParticipation("task-1", "user-1", "customer-1")
+	failing := NewMemoryVerifier()
+	failing.AddParticipation("task-1", "user-1", "customer-1")
+	failing.SetError(ErrUnavailable)
+	recovered := NewMemoryVerifier()
+	recovered.AddParticipation("task-1", "user-1", "customer-1")
+	recovered.SetError(ErrUnavailable)
+	recovered.SetError(nil)
+
+	tests := []struct {
+		name                       string
+		verifier                   *MemoryVerifier
+		taskID, userID, customerID string
+		want                       bool
+		wantErr                    error
+	}{
+		{name: "known participation", verifier: known, taskID: "task-1", userID: "user-1", customerID: "customer-1", want: true},
+		{name: "other task", verifier: known, taskID: "task-2", userID: "user-1", customerID: "customer-1", want: false},
+		{name: "other user", verifier: known, taskID: "task-1", userID: "user-2", customerID: "customer-1", want: false},
+		{name: "other customer", verifier: known, taskID: "task-1", userID: "user-1", customerID: "customer-2", want: false},
+		{name: "allow all", verifier: NewAllowAllVerifier(), taskID: "task-9", userID: "user-9", customerID: "customer-9", want: true},
+		{name: "outage", verifier: failing, taskID: "task-1", userID: "user-1", customerID: "customer-1", wantErr: ErrUnavailable},
+		{name: "after outage", verifier: recovered, taskID: "task-1", userID: "user-1", customerID: "customer-1", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.verifier.VerifyParticipation(context.Background(), tt.taskID, tt.userID, tt.customerID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("VerifyParticipation() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("VerifyParticipation() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
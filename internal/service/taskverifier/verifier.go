@@ -0,0 +1,21 @@
+package taskverifier
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrUnavailable is returned when the task service could not answer.
+	// Callers decide whether to fail open or closed.
+	ErrUnavailable = errors.New("task service unavailable")
+	// ErrFailed is returned when the task service answered with an error
+	// that says nothing about the participation. It never fails open.
+	ErrFailed = errors.New("task service failed")
+)
+
+// TaskVerifier tells whether a user took part in a task published by a
+// customer.
+type TaskVerifier interface {
+	VerifyParticipation(ctx context.Context, taskID string, userID string, customerID string) (bool, error)
+}
@@ -0,0 +1,86 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const feedbackAbuseTableName = "feedback_abuse_assessments"
+
+// SaveFeedbackAbuseAssessment stores the assessment of a feedback, replacing
+// the one of an earlier version. Feedback once held stays held.
+func (s *SqlStorage) SaveFeedbackAbuseAssessment(ctx context.Context, assessment *domain.AbuseAssessment) error {
+	query, args := sq.Insert(feedbackAbuseTableName).
+		Columns("feedback_id", "score", "velocity", "burst", "account_age", "deviation", "held").
+		Values(assessment.FeedbackID, assessment.Score, assessment.Velocity, assessment.Burst, assessment.AccountAge, assessment.Deviation, assessment.Held).
+		Suffix(`ON CONFLICT (feedback_id) DO UPDATE SET
+			score = EXCLUDED.score,
+			velocity = EXCLUDED.velocity,
+			burst = EXCLUDED.burst,
+			account_age = EXCLUDED.account_age,
+			deviation = EXCLUDED.deviation,
+			held = ` + feedbackAbuseTableName + `.held OR EXCLUDED.held,
+			created_at = now()`).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to save feedback abuse assessment", zap.Error(err), zap.String("feedback_id", assessment.FeedbackID))
+		return ErrFeedbackInternal
+	}
+	return nil
+}
+
+// GetFeedbackAbuseAssessments returns the assessments of the given feedbacks
+// keyed by feedback id.
+func (s *SqlStorage) GetFeedbackAbuseAssessments(ctx context.Context, feedbackIDs []string) (map[string]*domain.AbuseAssessment, error) {
+	assessments := make(map[string]*domain.AbuseAssessment, len(feedbackIDs))
+	if len(feedbackIDs) == 0 {
+		return assessments, nil
+	}
+
+	query, args := sq.Select("feedback_id", "score", "velocity", "burst", "account_age", "deviation", "held", "created_at").
+		From(feedbackAbuseTableName).
+		Where(sq.Eq{"feedback_id": feedbackIDs}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	rows := make([]*domain.AbuseAssessment, 0, len(feedbackIDs))
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get feedback abuse assessments", zap.Error(err), zap.Strings("feedback_ids", feedbackIDs))
+		return nil, ErrFeedbackInternal
+	}
+
+	for _, row := range rows {
+		assessments[row.FeedbackID] = row
+	}
+	return assessments, nil
+}
+
+// GetUserCreatedAt reads the registration time of a user from the users table
+// shared with the user service. Unknown users get the zero time.
+func (s *SqlStorage) GetUserCreatedAt(ctx context.Context, userID string) (time.Time, error) {
+	query, args := sq.Select("created_at").
+		From("users").
+		Where(sq.Eq{"max_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var createdAt time.Time
+	err := s.trf.Transaction(ctx).GetContext(ctx, &createdAt, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		s.log(ctx).Error("failed to get user created at", zap.Error(err), zap.String("user_id", userID))
+		return time.Time{}, ErrFeedbackInternal
+	}
+	return createdAt, nil
+}
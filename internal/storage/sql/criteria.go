@@ -0,0 +1,101 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const feedbackCriterionScoreTableName = "feedback_criterion_scores"
+
+type feedbackCriterionScoreRow struct {
+	FeedbackID string `db:"feedback_id"`
+	domain.CriterionScore
+}
+
+func (s *SqlStorage) CreateFeedbackScores(ctx context.Context, feedbackID string, scores []domain.CriterionScore) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	ib := sq.Insert(feedbackCriterionScoreTableName).
+		Columns("feedback_id", "criterion", "score")
+	for _, score := range scores {
+		ib = ib.Values(feedbackID, score.Criterion, score.Score)
+	}
+	query, args := ib.PlaceholderFormat(sq.Dollar).MustSql()
+
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to create feedback scores", zap.Error(err), zap.String("feedback_id", feedbackID))
+		return ErrFeedbackInternal
+	}
+	return nil
+}
+
+func (s *SqlStorage) DeleteFeedbackScores(ctx context.Context, feedbackID string) error {
+	query, args := sq.Delete(feedbackCriterionScoreTableName).
+		Where(sq.Eq{"feedback_id": feedbackID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to delete feedback scores", zap.Error(err), zap.String("feedback_id", feedbackID))
+		return ErrFeedbackInternal
+	}
+	return nil
+}
+
+// GetFeedbackScores returns the criterion scores of the given feedbacks keyed
+// by feedback id.
+func (s *SqlStorage) GetFeedbackScores(ctx context.Context, feedbackIDs []string) (map[string][]domain.CriterionScore, error) {
+	scores := make(map[string][]domain.CriterionScore, len(feedbackIDs))
+	if len(feedbackIDs) == 0 {
+		return scores, nil
+	}
+
+	query, args := sq.Select("feedback_id", "criterion", "score").
+		From(feedbackCriterionScoreTableName).
+		Where(sq.Eq{"feedback_id": feedbackIDs}).
+		OrderBy("feedback_id", "criterion").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var rows []feedbackCriterionScoreRow
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get feedback scores", zap.Error(err), zap.Strings("feedback_ids", feedbackIDs))
+		return nil, ErrFeedbackInternal
+	}
+
+	for _, row := range rows {
+		scores[row.FeedbackID] = append(scores[row.FeedbackID], row.CriterionScore)
+	}
+	return scores, nil
+}
+
+// GetCustomerCriterionRatings sums up the criterion scores of the published
+// feedbacks of a customer that opts select, like GetCustomerRating does.
+func (s *SqlStorage) GetCustomerCriterionRatings(ctx context.Context, customerID string, opts ...GetFeedbacksOptions) ([]*domain.CriterionRating, error) {
+	sb := sq.Select("cs.criterion", "COUNT(*) AS ratings_count", "SUM(cs.score) AS ratings_sum").
+		From(fmt.Sprintf("%s cs", feedbackCriterionScoreTableName)).
+		Join("feedbacks f ON f.id = cs.feedback_id").
+		Where(sq.Eq{"f.customer_id": customerID, "f.status": domain.FeedbackStatusPublished}).
+		GroupBy("cs.criterion").
+		OrderBy("cs.criterion").
+		PlaceholderFormat(sq.Dollar)
+	sb = applyFeedbackCountOptions(sb, opts)
+	query, args := sb.MustSql()
+
+	var ratings []*domain.CriterionRating
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &ratings, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get customer criterion ratings", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrCustomerInternal
+	}
+	return ratings, nil
+}
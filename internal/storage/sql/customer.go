@@ -6,21 +6,30 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jackc/pgx/v5/pgconn"
 	"go.uber.org/zap"
 )
 
-const customerTableName = "customers"
+const (
+	customerTableName       = "customers"
+	customerReturningSuffix = "RETURNING max_id, name, about, type, version, screening, created_at, updated_at, deleted_at, hidden_at"
+)
 
 var customerSelectColumns = []string{
 	"c.max_id",
 	"c.name",
 	"c.about",
 	"c.type",
+	"c.version",
+	"c.screening",
 	"c.created_at",
 	"c.updated_at",
+	"c.deleted_at",
+	"c.hidden_at",
 }
 
 type (
@@ -121,6 +130,95 @@ func WithCustomerType(customerType domain.CustomerType) GetCustomersOption {
 	}
 }
 
+func WithCustomerCreatedFrom(from time.Time) GetCustomersOption {
+	return customerWhereOption(!from.IsZero(), sq.GtOrEq{"c.created_at": from})
+}
+
+func WithCustomerCreatedTo(to time.Time) GetCustomersOption {
+	return customerWhereOption(!to.IsZero(), sq.Lt{"c.created_at": to})
+}
+
+func WithCustomerUpdatedFrom(from time.Time) GetCustomersOption {
+	return customerWhereOption(!from.IsZero(), sq.GtOrEq{"c.updated_at": from})
+}
+
+func WithCustomerUpdatedTo(to time.Time) GetCustomersOption {
+	return customerWhereOption(!to.IsZero(), sq.Lt{"c.updated_at": to})
+}
+
+// customerWhereOption applies pred to both the select and the count query
+// when enabled is true.
+func customerWhereOption(enabled bool, pred sq.Sqlizer) GetCustomersOption {
+	apply := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if enabled {
+			sb = sb.Where(pred)
+		}
+		return sb
+	}
+	return customerOptionFunc{selectFn: apply, countFn: apply}
+}
+
+var customerSortColumns = map[domain.CustomerSortField]string{
+	domain.CustomerSortByCreatedAt: "c.created_at",
+	domain.CustomerSortByUpdatedAt: "c.updated_at",
+	domain.CustomerSortByName:      "c.name",
+}
+
+// WithCustomerSort orders customers by a plain column. Reputation needs the
+// prior, see WithCustomerSortByReputation.
+func WithCustomerSort(field domain.CustomerSortField, direction domain.SortDirection) GetCustomersOption {
+	return customerOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			column, ok := customerSortColumns[field]
+			if !ok {
+				return sb
+			}
+			return sb.OrderBy(fmt.Sprintf("%s %s", column, sortDirectionSQL(direction)))
+		},
+	}
+}
+
+// WithCustomerSortByReputation orders customers by their Bayesian reputation
+// over the feedbacks selected by opts, see GetCustomerRatingWeights. It
+// mirrors reputation.Scorer so that sorting agrees with the scores returned to
+// clients.
+func WithCustomerSortByReputation(priorMean float64, priorWeight float64, halfLife time.Duration, direction domain.SortDirection, opts ...GetFeedbacksOptions) GetCustomersOption {
+	return customerOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			weights, args := ratingWeightsQuery(halfLife, opts).MustSql()
+			return sb.
+				LeftJoin(fmt.Sprintf("(%s) rw ON rw.customer_id = c.max_id", weights), args...).
+				OrderByClause(
+					fmt.Sprintf(
+						"(?::float8 * ?::float8 + COALESCE(rw.weighted_sum, 0)) / NULLIF(?::float8 + COALESCE(rw.weight, 0), 0) %s NULLS LAST",
+						sortDirectionSQL(direction),
+					),
+					priorWeight, priorMean, priorWeight,
+				)
+		},
+	}
+}
+
+func sortDirectionSQL(direction domain.SortDirection) string {
+	if direction == domain.SortAsc {
+		return "ASC"
+	}
+	return "DESC"
+}
+
+// WithCustomerAfter continues a keyset scan after the customer with the given
+// creation time and max id. It only affects the page, never the total count.
+func WithCustomerAfter(createdAt time.Time, maxID string) GetCustomersOption {
+	return customerOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if maxID != "" {
+				sb = sb.Where(sq.Expr("(c.created_at, c.max_id) < (?, ?)", createdAt, maxID))
+			}
+			return sb
+		},
+	}
+}
+
 func WithCustomerLimit(limit int) GetCustomersOption {
 	return customerOptionFunc{
 		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
@@ -146,7 +244,7 @@ func WithCustomerOffset(offset int) GetCustomersOption {
 func (s *SqlStorage) GetCustomerByMaxID(ctx context.Context, maxID string) (*domain.Customer, error) {
 	query, args := sq.Select(customerSelectColumns...).
 		From(fmt.Sprintf("%s c", customerTableName)).
-		Where(sq.Eq{"c.max_id": maxID}).
+		Where(sq.Eq{"c.max_id": maxID, "c.deleted_at": nil}).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
@@ -156,7 +254,7 @@ func (s *SqlStorage) GetCustomerByMaxID(ctx context.Context, maxID string) (*dom
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrCustomerNotFound
 		}
-		s.logger.Error("failed to get customer by max_id", zap.Error(err), zap.String("max_id", maxID))
+		s.log(ctx).Error("failed to get customer by max_id", zap.Error(err), zap.String("max_id", maxID))
 		return nil, ErrCustomerInternal
 	}
 
@@ -166,7 +264,7 @@ func (s *SqlStorage) GetCustomerByMaxID(ctx context.Context, maxID string) (*dom
 func (s *SqlStorage) GetCustomers(ctx context.Context, opts ...GetCustomersOption) ([]*domain.Customer, int, error) {
 	sb := sq.Select(customerSelectColumns...).
 		From(fmt.Sprintf("%s c", customerTableName)).
-		OrderBy("c.created_at DESC").
+		Where(sq.Eq{"c.deleted_at": nil, "c.hidden_at": nil}).
 		PlaceholderFormat(sq.Dollar)
 
 	if len(opts) > 0 {
@@ -178,18 +276,19 @@ func (s *SqlStorage) GetCustomers(ctx context.Context, opts ...GetCustomersOptio
 		}
 	}
 
-	query, args := sb.MustSql()
+	// applied last so that sort options take precedence and this only breaks ties
+	query, args := sb.OrderBy("c.created_at DESC", "c.max_id DESC").MustSql()
 
 	customers := make([]*domain.Customer, 0)
 	err := s.trf.Transaction(ctx).SelectContext(ctx, &customers, query, args...)
 	if err != nil {
-		s.logger.Error("failed to get customers", zap.Error(err))
+		s.log(ctx).Error("failed to get customers", zap.Error(err))
 		return nil, 0, ErrCustomerInternal
 	}
 
 	count, err := s.CountCustomers(ctx, opts...)
 	if err != nil {
-		s.logger.Error("failed to count customers", zap.Error(err))
+		s.log(ctx).Error("failed to count customers", zap.Error(err))
 		return nil, 0, ErrCustomerInternal
 	}
 
@@ -199,6 +298,7 @@ func (s *SqlStorage) GetCustomers(ctx context.Context, opts ...GetCustomersOptio
 func (s *SqlStorage) CountCustomers(ctx context.Context, opts ...GetCustomersOption) (int, error) {
 	sb := sq.Select("COUNT(*)").
 		From(fmt.Sprintf("%s c", customerTableName)).
+		Where(sq.Eq{"c.deleted_at": nil, "c.hidden_at": nil}).
 		PlaceholderFormat(sq.Dollar)
 
 	if len(opts) > 0 {
@@ -223,9 +323,9 @@ func (s *SqlStorage) CountCustomers(ctx context.Context, opts ...GetCustomersOpt
 
 func (s *SqlStorage) CreateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
 	query, args := sq.Insert(customerTableName).
-		Columns("max_id", "name", "about", "type").
-		Values(customer.MaxID, customer.Name, customer.About, customer.Type).
-		Suffix("RETURNING max_id, name, about, type, created_at, updated_at").
+		Columns("max_id", "name", "about", "type", "screening").
+		Values(customer.MaxID, customer.Name, customer.About, customer.Type, customer.Screening).
+		Suffix(customerReturningSuffix).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
@@ -241,21 +341,43 @@ func (s *SqlStorage) CreateCustomer(ctx context.Context, customer *domain.Custom
 				return nil, ErrCustomerInvalid
 			}
 		}
-		s.logger.Error("failed to create customer", zap.Error(err), zap.String("max_id", customer.MaxID))
+		s.log(ctx).Error("failed to create customer", zap.Error(err), zap.String("max_id", customer.MaxID))
 		return nil, ErrCustomerInternal
 	}
 
 	return &created, nil
 }
 
-func (s *SqlStorage) UpdateCustomer(ctx context.Context, customer *domain.Customer) (*domain.Customer, error) {
-	query, args := sq.Update(customerTableName).
-		Set("name", customer.Name).
-		Set("about", customer.About).
-		Set("type", customer.Type).
+func (s *SqlStorage) UpdateCustomer(ctx context.Context, customer *domain.Customer, fields []string) (*domain.Customer, error) {
+	ub := sq.Update(customerTableName)
+	for _, field := range fields {
+		switch field {
+		case domain.CustomerFieldName:
+			ub = ub.Set("name", customer.Name)
+		case domain.CustomerFieldAbout:
+			ub = ub.Set("about", customer.About)
+		case domain.CustomerFieldType:
+			ub = ub.Set("type", customer.Type)
+		default:
+			return nil, ErrCustomerInvalid
+		}
+	}
+
+	// The verdict always describes the text being written, so it is replaced
+	// even when the caller only touches one of the screened fields.
+	if slices.Contains(fields, domain.CustomerFieldName) || slices.Contains(fields, domain.CustomerFieldAbout) {
+		ub = ub.Set("screening", customer.Screening)
+	}
+
+	ub = ub.
+		Set("version", sq.Expr("version + 1")).
 		Set("updated_at", sq.Expr("NOW()")).
-		Where(sq.Eq{"max_id": customer.MaxID}).
-		Suffix("RETURNING max_id, name, about, type, created_at, updated_at").
+		Where(sq.Eq{"max_id": customer.MaxID, "deleted_at": nil})
+	if customer.Version > 0 {
+		ub = ub.Where(sq.Eq{"version": customer.Version})
+	}
+	query, args := ub.
+		Suffix(customerReturningSuffix).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
@@ -263,31 +385,205 @@ func (s *SqlStorage) UpdateCustomer(ctx context.Context, customer *domain.Custom
 	err := s.trf.Transaction(ctx).GetContext(ctx, &updated, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return nil, ErrCustomerNotFound
+			return nil, s.missingCustomerError(ctx, customer.MaxID, customer.Version)
 		}
 
-		s.logger.Error("failed to update customer", zap.Error(err), zap.String("max_id", customer.MaxID))
+		s.log(ctx).Error("failed to update customer", zap.Error(err), zap.String("max_id", customer.MaxID))
 		return nil, ErrCustomerInternal
 	}
 
 	return &updated, nil
 }
 
-func (s *SqlStorage) DeleteCustomer(ctx context.Context, maxID string) error {
-	query, args := sq.Delete(customerTableName).
+// DeleteCustomer marks the customer as deleted; the row is removed later by
+// PurgeCustomer. A positive expectedVersion makes the delete conditional on the
+// row still being at that version.
+func (s *SqlStorage) DeleteCustomer(ctx context.Context, maxID string, expectedVersion int64) error {
+	ub := sq.Update(customerTableName).
+		Set("deleted_at", sq.Expr("NOW()")).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"max_id": maxID, "deleted_at": nil})
+	if expectedVersion > 0 {
+		ub = ub.Where(sq.Eq{"version": expectedVersion})
+	}
+	query, args := ub.
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to delete customer", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.log(ctx).Error("failed to check affected rows when deleting customer", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+
+	if rowsAffected == 0 {
+		return s.missingCustomerError(ctx, maxID, expectedVersion)
+	}
+
+	return nil
+}
+
+// missingCustomerError tells apart a customer that does not exist from one
+// whose version no longer matches after a conditional write touched no rows.
+func (s *SqlStorage) missingCustomerError(ctx context.Context, maxID string, expectedVersion int64) error {
+	if expectedVersion <= 0 {
+		return ErrCustomerNotFound
+	}
+	_, err := s.GetCustomerByMaxID(ctx, maxID)
+	if err != nil {
+		return err
+	}
+	return ErrCustomerConflict
+}
+
+func (s *SqlStorage) RestoreCustomer(ctx context.Context, maxID string) (*domain.Customer, error) {
+	query, args := sq.Update(customerTableName).
+		Set("deleted_at", nil).
+		Set("version", sq.Expr("version + 1")).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"max_id": maxID}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		Suffix(customerReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var restored domain.Customer
+	err := s.trf.Transaction(ctx).GetContext(ctx, &restored, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCustomerNotFound
+		}
+		s.log(ctx).Error("failed to restore customer", zap.Error(err), zap.String("max_id", maxID))
+		return nil, ErrCustomerInternal
+	}
+
+	return &restored, nil
+}
+
+// GetDeletedCustomerIDs returns up to limit customers that were deleted before
+// the given time and are therefore due for purging.
+func (s *SqlStorage) GetDeletedCustomerIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]string, error) {
+	query, args := sq.Select("c.max_id").
+		From(fmt.Sprintf("%s c", customerTableName)).
+		Where(sq.Lt{"c.deleted_at": deletedBefore}).
+		OrderBy("c.deleted_at").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	ids := make([]string, 0, limit)
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &ids, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get deleted customers", zap.Error(err), zap.Time("deleted_before", deletedBefore))
+		return nil, ErrCustomerInternal
+	}
+
+	return ids, nil
+}
+
+// PurgeCustomer permanently removes a deleted customer together with the
+// feedback left for it and the reports against either. Call it inside a
+// transaction.
+func (s *SqlStorage) PurgeCustomer(ctx context.Context, maxID string) error {
+	// reports point at their target by type and id, so nothing cascades to them
+	query, args := sq.Delete(reportTableName).
+		Where(sq.Or{
+			sq.Eq{"target_type": domain.ReportTargetCustomer, "target_id": maxID},
+			sq.And{
+				sq.Eq{"target_type": domain.ReportTargetFeedback},
+				sq.Expr("target_id IN (SELECT id FROM feedbacks WHERE customer_id = ?)", maxID),
+			},
+		}).
+		Where(sq.Expr("EXISTS (SELECT 1 FROM customers WHERE max_id = ? AND deleted_at IS NOT NULL)", maxID)).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to purge customer reports", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+
+	query, args = sq.Delete("feedback_revisions").
+		Where(sq.Eq{"customer_id": maxID}).
+		Where(sq.Expr("EXISTS (SELECT 1 FROM customers WHERE max_id = ? AND deleted_at IS NOT NULL)", maxID)).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	_, err = s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to purge customer feedback revisions", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+
+	query, args = sq.Delete("feedbacks").
+		Where(sq.Eq{"customer_id": maxID}).
+		Where(sq.Expr("EXISTS (SELECT 1 FROM customers WHERE max_id = ? AND deleted_at IS NOT NULL)", maxID)).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	_, err = s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to purge customer feedbacks", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+
+	query, args = sq.Delete(customerTableName).
 		Where(sq.Eq{"max_id": maxID}).
+		Where(sq.NotEq{"deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to purge customer", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.log(ctx).Error("failed to check affected rows when purging customer", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+
+	if rowsAffected == 0 {
+		return ErrCustomerNotFound
+	}
+
+	return nil
+}
+
+// SetCustomerHidden hides the customer from public lookups, listings and
+// search, or shows it again. The customer's version is left alone since its
+// data does not change.
+func (s *SqlStorage) SetCustomerHidden(ctx context.Context, maxID string, hidden bool) error {
+	ub := sq.Update(customerTableName).
+		Where(sq.Eq{"max_id": maxID, "deleted_at": nil})
+	if hidden {
+		ub = ub.Set("hidden_at", sq.Expr("COALESCE(hidden_at, NOW())"))
+	} else {
+		ub = ub.Set("hidden_at", nil)
+	}
+	query, args := ub.
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
 
 	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
 	if err != nil {
-		s.logger.Error("failed to delete customer", zap.Error(err), zap.String("max_id", maxID))
+		s.log(ctx).Error("failed to set customer hidden", zap.Error(err), zap.String("max_id", maxID), zap.Bool("hidden", hidden))
 		return ErrCustomerInternal
 	}
 
 	rowsAffected, err := result.RowsAffected()
 	if err != nil {
-		s.logger.Error("failed to check affected rows when deleting customer", zap.Error(err), zap.String("max_id", maxID))
+		s.log(ctx).Error("failed to check affected rows when setting customer hidden", zap.Error(err), zap.String("max_id", maxID))
 		return ErrCustomerInternal
 	}
 
@@ -297,3 +593,25 @@ func (s *SqlStorage) DeleteCustomer(ctx context.Context, maxID string) error {
 
 	return nil
 }
+
+// LockCustomer locks the customer row for the rest of the transaction so that
+// concurrent writers touching the same customer run one after another.
+func (s *SqlStorage) LockCustomer(ctx context.Context, maxID string) error {
+	query, args := sq.Select("1").
+		From(customerTableName).
+		Where(sq.Eq{"max_id": maxID, "deleted_at": nil}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var locked int
+	err := s.trf.Transaction(ctx).GetContext(ctx, &locked, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrCustomerNotFound
+		}
+		s.log(ctx).Error("failed to lock customer", zap.Error(err), zap.String("max_id", maxID))
+		return ErrCustomerInternal
+	}
+	return nil
+}
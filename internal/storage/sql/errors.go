@@ -7,9 +7,21 @@ var (
 	ErrCustomerAlreadyExists = errors.New("customer already exists")
 	ErrCustomerInvalid       = errors.New("customer invalid")
 	ErrCustomerInternal      = errors.New("customer internal error")
+	ErrCustomerConflict      = errors.New("customer version conflict")
 
 	ErrFeedbackNotFound      = errors.New("feedback not found")
 	ErrFeedbackInternal      = errors.New("feedback internal error")
 	ErrFeedbackInvalid       = errors.New("feedback invalid")
 	ErrFeedbackAlreadyExists = errors.New("feedback already exists")
+
+	ErrFeedbackModerationNotFound = errors.New("feedback moderation not found")
+
+	ErrFeedbackReplyNotFound      = errors.New("feedback reply not found")
+	ErrFeedbackReplyAlreadyExists = errors.New("feedback reply already exists")
+
+	ErrReportNotFound      = errors.New("report not found")
+	ErrReportAlreadyExists = errors.New("report already exists")
+	ErrReportInternal      = errors.New("report internal error")
+
+	ErrRateLimitInternal = errors.New("rate limit internal error")
 )
@@ -5,6 +5,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
@@ -18,17 +19,23 @@ const (
 	pgErrForeignKeyViolation = "23503"
 )
 
+const feedbackReturningSuffix = "RETURNING id, user_id, task_id, customer_id, rating, comment, status, screening, created_at, updated_at"
+
+var feedbackSelectColumns = []string{
+	"f.id",
+	"f.user_id",
+	"f.task_id",
+	"f.customer_id",
+	"f.rating",
+	"f.comment",
+	"f.status",
+	"f.screening",
+	"f.created_at",
+	"f.updated_at",
+}
+
 func (s *SqlStorage) GetFeedbackByID(ctx context.Context, id string) (*domain.Feedback, error) {
-	query, args := sq.Select(
-		"f.id",
-		"f.user_id",
-		"f.task_id",
-		"f.customer_id",
-		"f.rating",
-		"f.comment",
-		"f.created_at",
-		"f.updated_at",
-	).
+	query, args := sq.Select(feedbackSelectColumns...).
 		From("feedbacks f").
 		Where(sq.Eq{"f.id": id}).
 		PlaceholderFormat(sq.Dollar).
@@ -39,7 +46,7 @@ func (s *SqlStorage) GetFeedbackByID(ctx context.Context, id string) (*domain.Fe
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, ErrFeedbackNotFound
 		}
-		s.logger.Error("failed to get feedback by id", zap.Error(err), zap.String("id", id))
+		s.log(ctx).Error("failed to get feedback by id", zap.Error(err), zap.String("id", id))
 		return nil, ErrFeedbackInternal
 	}
 	return &feedback, nil
@@ -48,11 +55,13 @@ func (s *SqlStorage) GetFeedbackByID(ctx context.Context, id string) (*domain.Fe
 func (s *SqlStorage) CreateFeedback(ctx context.Context, feedback *domain.Feedback) (*domain.Feedback, error) {
 	feedback.ID = uuid.NewString()
 	query, args := sq.Insert("feedbacks").
-		Columns("id", "user_id", "task_id", "rating", "comment").
-		Values(feedback.ID, feedback.UserID, feedback.TaskID, feedback.Rating, feedback.Comment).
+		Columns("id", "user_id", "task_id", "customer_id", "rating", "comment", "status", "screening").
+		Values(feedback.ID, feedback.UserID, feedback.TaskID, feedback.CustomerID, feedback.Rating, feedback.Comment, feedback.Status, feedback.Screening).
+		Suffix(feedbackReturningSuffix).
 		PlaceholderFormat(sq.Dollar).
 		MustSql()
-	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	var created domain.Feedback
+	err := s.trf.Transaction(ctx).GetContext(ctx, &created, query, args...)
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) {
@@ -63,71 +72,283 @@ func (s *SqlStorage) CreateFeedback(ctx context.Context, feedback *domain.Feedba
 				return nil, ErrFeedbackInvalid
 			}
 		}
-		s.logger.Error("failed to create feedback", zap.Error(err), zap.String("user_id", feedback.UserID), zap.String("task_id", feedback.TaskID))
+		s.log(ctx).Error("failed to create feedback", zap.Error(err), zap.String("user_id", feedback.UserID), zap.String("task_id", feedback.TaskID))
 		return nil, ErrFeedbackInternal
 	}
-	return feedback, nil
+	return &created, nil
 }
 
-type GetFeedbacksOptions func(sb sq.SelectBuilder) sq.SelectBuilder
+// GetFeedbackForUpdate reads a feedback and locks its row until the end of
+// the surrounding transaction.
+func (s *SqlStorage) GetFeedbackForUpdate(ctx context.Context, id string) (*domain.Feedback, error) {
+	query, args := sq.Select(feedbackSelectColumns...).
+		From("feedbacks f").
+		Where(sq.Eq{"f.id": id}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var feedback domain.Feedback
+	err := s.trf.Transaction(ctx).GetContext(ctx, &feedback, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedbackNotFound
+		}
+		s.log(ctx).Error("failed to lock feedback", zap.Error(err), zap.String("id", id))
+		return nil, ErrFeedbackInternal
+	}
+	return &feedback, nil
+}
 
-func WithTaskID(taskID string) GetFeedbacksOptions {
-	return func(sb sq.SelectBuilder) sq.SelectBuilder {
-		if taskID != "" {
-			sb = sb.Where(sq.Eq{"f.task_id": taskID})
+func (s *SqlStorage) UpdateFeedback(ctx context.Context, feedback *domain.Feedback) (*domain.Feedback, error) {
+	query, args := sq.Update("feedbacks").
+		Set("rating", feedback.Rating).
+		Set("comment", feedback.Comment).
+		Set("status", feedback.Status).
+		Set("screening", feedback.Screening).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": feedback.ID}).
+		Suffix(feedbackReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var updated domain.Feedback
+	err := s.trf.Transaction(ctx).GetContext(ctx, &updated, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedbackNotFound
 		}
-		return sb
+		s.log(ctx).Error("failed to update feedback", zap.Error(err), zap.String("id", feedback.ID))
+		return nil, ErrFeedbackInternal
 	}
+	return &updated, nil
 }
 
-func WithUserID(userID string) GetFeedbacksOptions {
-	return func(sb sq.SelectBuilder) sq.SelectBuilder {
-		if userID != "" {
-			sb = sb.Where(sq.Eq{"f.user_id": userID})
+// DeleteFeedback removes a feedback together with the reports against it. Call
+// it inside a transaction.
+func (s *SqlStorage) DeleteFeedback(ctx context.Context, id string) error {
+	// reports point at their target by type and id, so nothing cascades to them
+	query, args := sq.Delete(reportTableName).
+		Where(sq.Eq{"target_type": domain.ReportTargetFeedback, "target_id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to delete feedback reports", zap.Error(err), zap.String("id", id))
+		return ErrFeedbackInternal
+	}
+
+	query, args = sq.Delete("feedbacks").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to delete feedback", zap.Error(err), zap.String("id", id))
+		return ErrFeedbackInternal
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.log(ctx).Error("failed to check affected rows when deleting feedback", zap.Error(err), zap.String("id", id))
+		return ErrFeedbackInternal
+	}
+	if rowsAffected == 0 {
+		return ErrFeedbackNotFound
+	}
+	return nil
+}
+
+func (s *SqlStorage) SetFeedbackStatus(ctx context.Context, id string, status domain.FeedbackStatus) (*domain.Feedback, error) {
+	query, args := sq.Update("feedbacks").
+		Set("status", status).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"id": id}).
+		Suffix(feedbackReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var updated domain.Feedback
+	err := s.trf.Transaction(ctx).GetContext(ctx, &updated, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedbackNotFound
 		}
-		return sb
+		s.log(ctx).Error("failed to set feedback status", zap.Error(err), zap.String("id", id), zap.String("status", string(status)))
+		return nil, ErrFeedbackInternal
 	}
+	return &updated, nil
 }
 
-func WithCustomerID(customerID string) GetFeedbacksOptions {
-	return func(sb sq.SelectBuilder) sq.SelectBuilder {
-		if customerID != "" {
-			sb = sb.Where(sq.Eq{"f.customer_id": customerID})
+func (s *SqlStorage) CreateFeedbackModeration(ctx context.Context, moderation *domain.FeedbackModeration) error {
+	moderation.ID = uuid.NewString()
+	query, args := sq.Insert("feedback_moderations").
+		Columns("id", "feedback_id", "moderator_id", "decision", "reason").
+		Values(moderation.ID, moderation.FeedbackID, moderation.ModeratorID, moderation.Decision, moderation.Reason).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to create feedback moderation", zap.Error(err), zap.String("feedback_id", moderation.FeedbackID))
+		return ErrFeedbackInternal
+	}
+	return nil
+}
+
+// GetLatestFeedbackModeration returns the last moderation decision made on a
+// feedback.
+func (s *SqlStorage) GetLatestFeedbackModeration(ctx context.Context, feedbackID string) (*domain.FeedbackModeration, error) {
+	query, args := sq.Select("id", "feedback_id", "moderator_id", "decision", "reason", "created_at").
+		From("feedback_moderations").
+		Where(sq.Eq{"feedback_id": feedbackID}).
+		OrderBy("created_at DESC").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var moderation domain.FeedbackModeration
+	err := s.trf.Transaction(ctx).GetContext(ctx, &moderation, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedbackModerationNotFound
 		}
-		return sb
+		s.log(ctx).Error("failed to get feedback moderation", zap.Error(err), zap.String("feedback_id", feedbackID))
+		return nil, ErrFeedbackInternal
 	}
+	return &moderation, nil
 }
 
-func WithLimit(limit int) GetFeedbacksOptions {
-	return func(sb sq.SelectBuilder) sq.SelectBuilder {
-		if limit > 0 {
-			sb = sb.Limit(uint64(limit))
+func (s *SqlStorage) CreateFeedbackRevision(ctx context.Context, revision *domain.FeedbackRevision) error {
+	revision.ID = uuid.NewString()
+	query, args := sq.Insert("feedback_revisions").
+		Columns("id", "feedback_id", "customer_id", "user_id", "action", "rating", "comment").
+		Values(revision.ID, revision.FeedbackID, revision.CustomerID, revision.UserID, revision.Action, revision.Rating, revision.Comment).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to create feedback revision", zap.Error(err), zap.String("feedback_id", revision.FeedbackID))
+		return ErrFeedbackInternal
+	}
+	return nil
+}
+
+type (
+	feedbackOption interface {
+		applySelect(sq.SelectBuilder) sq.SelectBuilder
+		applyCount(sq.SelectBuilder) sq.SelectBuilder
+	}
+
+	feedbackOptionFunc struct {
+		selectFn func(sq.SelectBuilder) sq.SelectBuilder
+		countFn  func(sq.SelectBuilder) sq.SelectBuilder
+	}
+)
+
+func (f feedbackOptionFunc) applySelect(sb sq.SelectBuilder) sq.SelectBuilder {
+	if f.selectFn != nil {
+		return f.selectFn(sb)
+	}
+	return sb
+}
+
+func (f feedbackOptionFunc) applyCount(sb sq.SelectBuilder) sq.SelectBuilder {
+	if f.countFn != nil {
+		return f.countFn(sb)
+	}
+	return sb
+}
+
+type GetFeedbacksOptions interface {
+	feedbackOption
+}
+
+// feedbackWhereOption applies pred to both the select and the count query
+// when enabled is true.
+func feedbackWhereOption(enabled bool, pred sq.Sqlizer) GetFeedbacksOptions {
+	apply := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if enabled {
+			sb = sb.Where(pred)
 		}
 		return sb
 	}
+	return feedbackOptionFunc{selectFn: apply, countFn: apply}
+}
+
+func WithTaskID(taskID string) GetFeedbacksOptions {
+	return feedbackWhereOption(taskID != "", sq.Eq{"f.task_id": taskID})
+}
+
+func WithUserID(userID string) GetFeedbacksOptions {
+	return feedbackWhereOption(userID != "", sq.Eq{"f.user_id": userID})
+}
+
+func WithCustomerID(customerID string) GetFeedbacksOptions {
+	return feedbackWhereOption(customerID != "", sq.Eq{"f.customer_id": customerID})
+}
+
+func WithMinRating(rating int) GetFeedbacksOptions {
+	return feedbackWhereOption(rating > 0, sq.GtOrEq{"f.rating": rating})
+}
+
+func WithMaxRating(rating int) GetFeedbacksOptions {
+	return feedbackWhereOption(rating > 0, sq.LtOrEq{"f.rating": rating})
+}
+
+func WithCreatedFrom(from time.Time) GetFeedbacksOptions {
+	return feedbackWhereOption(!from.IsZero(), sq.GtOrEq{"f.created_at": from})
+}
+
+func WithCreatedTo(to time.Time) GetFeedbacksOptions {
+	return feedbackWhereOption(!to.IsZero(), sq.Lt{"f.created_at": to})
+}
+
+func WithStatuses(statuses ...domain.FeedbackStatus) GetFeedbacksOptions {
+	return feedbackWhereOption(len(statuses) > 0, sq.Eq{"f.status": statuses})
+}
+
+// WithFeedbacksAfter continues a keyset scan after the feedback with the given
+// creation time and id. It only affects the page, never the total count.
+func WithFeedbacksAfter(createdAt time.Time, id string) GetFeedbacksOptions {
+	return feedbackOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if id != "" {
+				sb = sb.Where(sq.Expr("(f.created_at, f.id) < (?, ?)", createdAt, id))
+			}
+			return sb
+		},
+	}
+}
+
+func WithLimit(limit int) GetFeedbacksOptions {
+	return feedbackOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if limit > 0 {
+				sb = sb.Limit(uint64(limit))
+			}
+			return sb
+		},
+	}
 }
 
 func WithOffset(offset int) GetFeedbacksOptions {
-	return func(sb sq.SelectBuilder) sq.SelectBuilder {
-		return sb.Offset(uint64(offset))
+	return feedbackOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if offset > 0 {
+				sb = sb.Offset(uint64(offset))
+			}
+			return sb
+		},
 	}
 }
 
 func (s *SqlStorage) GetFeedbacks(ctx context.Context, opts ...GetFeedbacksOptions) ([]*domain.Feedback, int, error) {
-	sb := sq.Select(
-		"f.id",
-		"f.user_id",
-		"f.task_id",
-		"f.rating",
-		"f.comment",
-	).
+	sb := sq.Select(feedbackSelectColumns...).
 		From("feedbacks f").
 		PlaceholderFormat(sq.Dollar).
-		OrderBy("f.created_at DESC")
+		OrderBy("f.created_at DESC", "f.id DESC")
 
 	if len(opts) > 0 {
 		for _, opt := range opts {
-			sb = opt(sb)
+			if opt == nil {
+				continue
+			}
+			sb = opt.applySelect(sb)
 		}
 	}
 
@@ -147,10 +368,13 @@ func (s *SqlStorage) GetFeedbacks(ctx context.Context, opts ...GetFeedbacksOptio
 }
 
 func (s *SqlStorage) CountFeedbacks(ctx context.Context, opts ...GetFeedbacksOptions) (int, error) {
-	sb := sq.Select("COUNT(*)").From("feedbacks").PlaceholderFormat(sq.Dollar)
+	sb := sq.Select("COUNT(*)").From("feedbacks f").PlaceholderFormat(sq.Dollar)
 	if len(opts) > 0 {
 		for _, opt := range opts {
-			sb = opt(sb)
+			if opt == nil {
+				continue
+			}
+			sb = opt.applyCount(sb)
 		}
 	}
 	query, args := sb.MustSql()
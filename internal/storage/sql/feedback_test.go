@@ -0,0 +1,114 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestCountFeedbacks(t *testing.T) {
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		opts      []GetFeedbacksOptions
+		wantQuery string
+		wantArgs  []driver.Value
+	}{
+		{
+			name:      "no filters",
+			wantQuery: "SELECT COUNT(*) FROM feedbacks f",
+		},
+		{
+			name:      "empty filters are skipped",
+			opts:      []GetFeedbacksOptions{WithTaskID(""), WithMinRating(0), WithCreatedTo(time.Time{}), nil},
+			wantQuery: "SELECT COUNT(*) FROM feedbacks f",
+		},
+		{
+			name: "all filters",
+			opts: []GetFeedbacksOptions{
+				WithTaskID("task-1"),
+				WithUserID("user-1"),
+				WithCustomerID("customer-1"),
+				WithMinRating(2),
+				WithMaxRating(4),
+				WithCreatedFrom(from),
+				WithCreatedTo(to),
+				WithStatuses(domain.FeedbackStatusPublished),
+			},
+			wantQuery: "SELECT COUNT(*) FROM feedbacks f WHERE f.task_id = $1 AND f.user_id = $2 AND f.customer_id = $3" +
+				" AND f.rating >= $4 AND f.rating <= $5 AND f.created_at >= $6 AND f.created_at < $7 AND f.status IN ($8)",
+			wantArgs: []driver.Value{"task-1", "user-1", "customer-1", 2, 4, from, to, string(domain.FeedbackStatusPublished)},
+		},
+		{
+			name:      "created to is exclusive",
+			opts:      []GetFeedbacksOptions{WithCreatedTo(to)},
+			wantQuery: "SELECT COUNT(*) FROM feedbacks f WHERE f.created_at < $1",
+			wantArgs:  []driver.Value{to},
+		},
+		{
+			name:      "page options do not narrow the count",
+			opts:      []GetFeedbacksOptions{WithFeedbacksAfter(from, "feedback-1"), WithLimit(10), WithOffset(20)},
+			wantQuery: "SELECT COUNT(*) FROM feedbacks f",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, mock := newMockStorage(t)
+			mock.ExpectQuery(tt.wantQuery).
+				WithArgs(tt.wantArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+			count, err := storage.CountFeedbacks(context.Background(), tt.opts...)
+			if err != nil {
+				t.Fatalf("CountFeedbacks() error = %v", err)
+			}
+			if count != 7 {
+				t.Errorf("CountFeedbacks() = %d, want 7", count)
+			}
+		})
+	}
+}
+
+func TestCountFeedbacksError(t *testing.T) {
+	storage, mock := newMockStorage(t)
+	mock.ExpectQuery("SELECT COUNT(*) FROM feedbacks f").WillReturnError(errors.New("connection reset"))
+
+	if _, err := storage.CountFeedbacks(context.Background()); !errors.Is(err, ErrFeedbackInternal) {
+		t.Errorf("CountFeedbacks() error = %v, want %v", err, ErrFeedbackInternal)
+	}
+}
+
+func TestDeleteFeedback(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted int64
+		wantErr error
+	}{
+		{name: "deleted", deleted: 1},
+		{name: "not found", deleted: 0, wantErr: ErrFeedbackNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, mock := newMockStorage(t)
+			mock.ExpectExec("DELETE FROM reports WHERE target_id = $1 AND target_type = $2").
+				WithArgs("feedback-1", string(domain.ReportTargetFeedback)).
+				WillReturnResult(sqlmock.NewResult(0, 2))
+			mock.ExpectExec("DELETE FROM feedbacks WHERE id = $1").
+				WithArgs("feedback-1").
+				WillReturnResult(sqlmock.NewResult(0, tt.deleted))
+
+			if err := storage.DeleteFeedback(context.Background(), "feedback-1"); !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteFeedback() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const rateLimitBucketTableName = "rate_limit_buckets"
+
+// LockRateLimitBucket locks the bucket of key for the current transaction and
+// returns its tokens together with the time passed since they were saved. A
+// missing bucket is created holding tokens. Elapsed time is measured on the
+// database clock so that replicas agree on it, and once the lock is held, so
+// that waiting for it does not count twice.
+func (s *SqlStorage) LockRateLimitBucket(ctx context.Context, key string, tokens float64) (float64, time.Duration, error) {
+	query, args := sq.Insert(rateLimitBucketTableName).
+		Columns("key", "tokens").
+		Values(key, tokens).
+		Suffix("ON CONFLICT (key) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to create rate limit bucket", zap.Error(err), zap.String("key", key))
+		return 0, 0, ErrRateLimitInternal
+	}
+
+	query, args = sq.Select("tokens", "EXTRACT(EPOCH FROM clock_timestamp() - updated_at)::float8 AS elapsed").
+		From(rateLimitBucketTableName).
+		Where(sq.Eq{"key": key}).
+		Suffix("FOR UPDATE").
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var bucket struct {
+		Tokens  float64 `db:"tokens"`
+		Elapsed float64 `db:"elapsed"`
+	}
+	err = s.trf.Transaction(ctx).GetContext(ctx, &bucket, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to lock rate limit bucket", zap.Error(err), zap.String("key", key))
+		return 0, 0, ErrRateLimitInternal
+	}
+	return bucket.Tokens, time.Duration(bucket.Elapsed * float64(time.Second)), nil
+}
+
+func (s *SqlStorage) SaveRateLimitBucket(ctx context.Context, key string, tokens float64) error {
+	query, args := sq.Update(rateLimitBucketTableName).
+		Set("tokens", tokens).
+		Set("updated_at", sq.Expr("clock_timestamp()")).
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to save rate limit bucket", zap.Error(err), zap.String("key", key))
+		return ErrRateLimitInternal
+	}
+	return nil
+}
+
+func (s *SqlStorage) DeleteIdleRateLimitBuckets(ctx context.Context, idle time.Duration) error {
+	query, args := sq.Delete(rateLimitBucketTableName).
+		Where(sq.Expr("updated_at < now() - make_interval(secs => ?)", idle.Seconds())).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	_, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to delete idle rate limit buckets", zap.Error(err))
+		return ErrRateLimitInternal
+	}
+	return nil
+}
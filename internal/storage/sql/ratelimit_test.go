@@ -0,0 +1,35 @@
+package sql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestRateLimitBucketUsesWallClock(t *testing.T) {
+	storage, mock := newMockStorage(t)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO rate_limit_buckets (key,tokens) VALUES ($1,$2) ON CONFLICT (key) DO NOTHING").
+		WithArgs("customer:10.1.2.3", 3.0).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery("SELECT tokens, EXTRACT(EPOCH FROM clock_timestamp() - updated_at)::float8 AS elapsed FROM rate_limit_buckets WHERE key = $1 FOR UPDATE").
+		WithArgs("customer:10.1.2.3").
+		WillReturnRows(sqlmock.NewRows([]string{"tokens", "elapsed"}).AddRow(0.5, 1.5))
+	mock.ExpectExec("UPDATE rate_limit_buckets SET tokens = $1, updated_at = clock_timestamp() WHERE key = $2").
+		WithArgs(1.0, "customer:10.1.2.3").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	tokens, elapsed, err := storage.LockRateLimitBucket(ctx, "customer:10.1.2.3", 3)
+	if err != nil {
+		t.Fatalf("LockRateLimitBucket() error = %v", err)
+	}
+	if tokens != 0.5 || elapsed != 1500*time.Millisecond {
+		t.Errorf("LockRateLimitBucket() = %v, %v, want 0.5, 1.5s", tokens, elapsed)
+	}
+	if err := storage.SaveRateLimitBucket(ctx, "customer:10.1.2.3", 1); err != nil {
+		t.Fatalf("SaveRateLimitBucket() error = %v", err)
+	}
+}
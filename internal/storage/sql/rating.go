@@ -0,0 +1,142 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+// ratingCountColumns sum up the ratings of the feedbacks f.
+var ratingCountColumns = []string{
+	"COUNT(*) AS ratings_count",
+	"COALESCE(SUM(f.rating), 0) AS ratings_sum",
+	"COUNT(*) FILTER (WHERE f.rating = 1) AS stars_1",
+	"COUNT(*) FILTER (WHERE f.rating = 2) AS stars_2",
+	"COUNT(*) FILTER (WHERE f.rating = 3) AS stars_3",
+	"COUNT(*) FILTER (WHERE f.rating = 4) AS stars_4",
+	"COUNT(*) FILTER (WHERE f.rating = 5) AS stars_5",
+}
+
+type ratingCountsRow struct {
+	Count     int          `db:"ratings_count"`
+	Sum       int          `db:"ratings_sum"`
+	Stars1    int          `db:"stars_1"`
+	Stars2    int          `db:"stars_2"`
+	Stars3    int          `db:"stars_3"`
+	Stars4    int          `db:"stars_4"`
+	Stars5    int          `db:"stars_5"`
+	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+func (r *ratingCountsRow) distribution() map[int]int {
+	return map[int]int{
+		1: r.Stars1,
+		2: r.Stars2,
+		3: r.Stars3,
+		4: r.Stars4,
+		5: r.Stars5,
+	}
+}
+
+func applyFeedbackCountOptions(sb sq.SelectBuilder, opts []GetFeedbacksOptions) sq.SelectBuilder {
+	for _, opt := range opts {
+		if opt != nil {
+			sb = opt.applyCount(sb)
+		}
+	}
+	return sb
+}
+
+// GetCustomerRating sums up the published feedbacks of a customer that opts
+// select, e.g. leaving out reviews still under the blind period.
+func (s *SqlStorage) GetCustomerRating(ctx context.Context, customerID string, opts ...GetFeedbacksOptions) (*domain.CustomerRating, error) {
+	query, args := sq.Select("created_at").
+		From(customerTableName).
+		Where(sq.Eq{"max_id": customerID, "deleted_at": nil}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	var createdAt time.Time
+	err := s.trf.Transaction(ctx).GetContext(ctx, &createdAt, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrCustomerNotFound
+		}
+		s.log(ctx).Error("failed to get customer rating", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrCustomerInternal
+	}
+
+	sb := sq.Select(ratingCountColumns...).
+		Column("MAX(f.updated_at) AS updated_at").
+		From("feedbacks f").
+		Where(sq.Eq{"f.customer_id": customerID, "f.status": domain.FeedbackStatusPublished}).
+		PlaceholderFormat(sq.Dollar)
+	query, args = applyFeedbackCountOptions(sb, opts).MustSql()
+
+	var counts ratingCountsRow
+	err = s.trf.Transaction(ctx).GetContext(ctx, &counts, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to count customer rating", zap.Error(err), zap.String("customer_id", customerID))
+		return nil, ErrCustomerInternal
+	}
+	rating := &domain.CustomerRating{
+		CustomerID:   customerID,
+		Count:        counts.Count,
+		Sum:          counts.Sum,
+		Distribution: counts.distribution(),
+		UpdatedAt:    createdAt,
+	}
+	if counts.UpdatedAt.Valid {
+		rating.UpdatedAt = counts.UpdatedAt.Time
+	}
+	return rating, nil
+}
+
+// ratingWeightsQuery selects per-customer weighted rating sums over the
+// published feedbacks the options select, each weighted by
+// 0.5^(age/halfLife) when halfLife is positive.
+func ratingWeightsQuery(halfLife time.Duration, opts []GetFeedbacksOptions) sq.SelectBuilder {
+	sb := sq.Select("f.customer_id")
+	if halfLife <= 0 {
+		sb = sb.Column("SUM(f.rating)::float8 AS weighted_sum").
+			Column("COUNT(*)::float8 AS weight")
+	} else {
+		decay := "POWER(0.5, EXTRACT(EPOCH FROM (NOW() - f.created_at))::float8 / ?::float8)"
+		sb = sb.Column(fmt.Sprintf("SUM(f.rating * %s) AS weighted_sum", decay), halfLife.Seconds()).
+			Column(fmt.Sprintf("SUM(%s) AS weight", decay), halfLife.Seconds())
+	}
+	sb = sb.From("feedbacks f").
+		Where(sq.Eq{"f.status": domain.FeedbackStatusPublished}).
+		GroupBy("f.customer_id")
+	return applyFeedbackCountOptions(sb, opts)
+}
+
+func (s *SqlStorage) GetCustomerRatingWeights(ctx context.Context, customerIDs []string, halfLife time.Duration, opts ...GetFeedbacksOptions) (map[string]*domain.WeightedRating, error) {
+	weights := make(map[string]*domain.WeightedRating, len(customerIDs))
+	if len(customerIDs) == 0 {
+		return weights, nil
+	}
+
+	query, args := ratingWeightsQuery(halfLife, opts).
+		Where(sq.Eq{"f.customer_id": customerIDs}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	rows := make([]*domain.WeightedRating, 0, len(customerIDs))
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get customer rating weights", zap.Error(err), zap.Strings("customer_ids", customerIDs))
+		return nil, ErrCustomerInternal
+	}
+
+	for _, row := range rows {
+		weights[row.CustomerID] = row
+	}
+	return weights, nil
+}
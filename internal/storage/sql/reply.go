@@ -0,0 +1,123 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+const (
+	feedbackReplyTableName       = "feedback_replies"
+	feedbackReplyReturningSuffix = "RETURNING id, feedback_id, customer_id, text, screening, created_at, updated_at"
+)
+
+var feedbackReplySelectColumns = []string{
+	"id",
+	"feedback_id",
+	"customer_id",
+	"text",
+	"screening",
+	"created_at",
+	"updated_at",
+}
+
+func (s *SqlStorage) CreateFeedbackReply(ctx context.Context, reply *domain.FeedbackReply) (*domain.FeedbackReply, error) {
+	reply.ID = uuid.NewString()
+	query, args := sq.Insert(feedbackReplyTableName).
+		Columns("id", "feedback_id", "customer_id", "text", "screening").
+		Values(reply.ID, reply.FeedbackID, reply.CustomerID, reply.Text, reply.Screening).
+		Suffix(feedbackReplyReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var created domain.FeedbackReply
+	err := s.trf.Transaction(ctx).GetContext(ctx, &created, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgErrUniqueViolation:
+				return nil, ErrFeedbackReplyAlreadyExists
+			case pgErrForeignKeyViolation:
+				return nil, ErrFeedbackNotFound
+			}
+		}
+		s.log(ctx).Error("failed to create feedback reply", zap.Error(err), zap.String("feedback_id", reply.FeedbackID))
+		return nil, ErrFeedbackInternal
+	}
+	return &created, nil
+}
+
+func (s *SqlStorage) UpdateFeedbackReply(ctx context.Context, reply *domain.FeedbackReply) (*domain.FeedbackReply, error) {
+	query, args := sq.Update(feedbackReplyTableName).
+		Set("text", reply.Text).
+		Set("screening", reply.Screening).
+		Set("updated_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"feedback_id": reply.FeedbackID}).
+		Suffix(feedbackReplyReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var updated domain.FeedbackReply
+	err := s.trf.Transaction(ctx).GetContext(ctx, &updated, query, args...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedbackReplyNotFound
+		}
+		s.log(ctx).Error("failed to update feedback reply", zap.Error(err), zap.String("feedback_id", reply.FeedbackID))
+		return nil, ErrFeedbackInternal
+	}
+	return &updated, nil
+}
+
+func (s *SqlStorage) DeleteFeedbackReply(ctx context.Context, feedbackID string) error {
+	query, args := sq.Delete(feedbackReplyTableName).
+		Where(sq.Eq{"feedback_id": feedbackID}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	result, err := s.trf.Transaction(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to delete feedback reply", zap.Error(err), zap.String("feedback_id", feedbackID))
+		return ErrFeedbackInternal
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		s.log(ctx).Error("failed to check affected rows when deleting feedback reply", zap.Error(err), zap.String("feedback_id", feedbackID))
+		return ErrFeedbackInternal
+	}
+	if rowsAffected == 0 {
+		return ErrFeedbackReplyNotFound
+	}
+	return nil
+}
+
+// GetFeedbackReplies returns the replies to the given feedbacks keyed by
+// feedback id. Feedbacks without a reply are absent from the map.
+func (s *SqlStorage) GetFeedbackReplies(ctx context.Context, feedbackIDs []string) (map[string]*domain.FeedbackReply, error) {
+	replies := make(map[string]*domain.FeedbackReply, len(feedbackIDs))
+	if len(feedbackIDs) == 0 {
+		return replies, nil
+	}
+
+	query, args := sq.Select(feedbackReplySelectColumns...).
+		From(feedbackReplyTableName).
+		Where(sq.Eq{"feedback_id": feedbackIDs}).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	rows := make([]*domain.FeedbackReply, 0, len(feedbackIDs))
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get feedback replies", zap.Error(err), zap.Strings("feedback_ids", feedbackIDs))
+		return nil, ErrFeedbackInternal
+	}
+
+	for _, row := range rows {
+		replies[row.FeedbackID] = row
+	}
+	return replies, nil
+}
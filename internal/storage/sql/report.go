@@ -0,0 +1,223 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+const (
+	reportTableName       = "reports"
+	reportReturningSuffix = "RETURNING id, target_type, target_id, reporter_id, reason, comment, status, resolved_by, resolution_note, created_at, resolved_at"
+)
+
+var reportSelectColumns = []string{
+	"r.id",
+	"r.target_type",
+	"r.target_id",
+	"r.reporter_id",
+	"r.reason",
+	"r.comment",
+	"r.status",
+	"r.resolved_by",
+	"r.resolution_note",
+	"r.created_at",
+	"r.resolved_at",
+}
+
+type (
+	reportOption interface {
+		applySelect(sq.SelectBuilder) sq.SelectBuilder
+		applyCount(sq.SelectBuilder) sq.SelectBuilder
+	}
+
+	reportOptionFunc struct {
+		selectFn func(sq.SelectBuilder) sq.SelectBuilder
+		countFn  func(sq.SelectBuilder) sq.SelectBuilder
+	}
+)
+
+func (f reportOptionFunc) applySelect(sb sq.SelectBuilder) sq.SelectBuilder {
+	if f.selectFn != nil {
+		return f.selectFn(sb)
+	}
+	return sb
+}
+
+func (f reportOptionFunc) applyCount(sb sq.SelectBuilder) sq.SelectBuilder {
+	if f.countFn != nil {
+		return f.countFn(sb)
+	}
+	return sb
+}
+
+type GetReportsOption interface {
+	reportOption
+}
+
+// reportWhereOption applies pred to both the select and the count query when
+// enabled is true.
+func reportWhereOption(enabled bool, pred sq.Sqlizer) GetReportsOption {
+	apply := func(sb sq.SelectBuilder) sq.SelectBuilder {
+		if enabled {
+			sb = sb.Where(pred)
+		}
+		return sb
+	}
+	return reportOptionFunc{selectFn: apply, countFn: apply}
+}
+
+func WithReportTargetType(targetType domain.ReportTarget) GetReportsOption {
+	return reportWhereOption(targetType != "", sq.Eq{"r.target_type": targetType})
+}
+
+func WithReportTargetID(targetID string) GetReportsOption {
+	return reportWhereOption(targetID != "", sq.Eq{"r.target_id": targetID})
+}
+
+func WithReportStatus(status domain.ReportStatus) GetReportsOption {
+	return reportWhereOption(status != "", sq.Eq{"r.status": status})
+}
+
+func WithReportReason(reason domain.ReportReason) GetReportsOption {
+	return reportWhereOption(reason != "", sq.Eq{"r.reason": reason})
+}
+
+func WithReportsAfter(createdAt time.Time, id string) GetReportsOption {
+	return reportOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if id != "" {
+				sb = sb.Where(sq.Expr("(r.created_at, r.id) < (?, ?)", createdAt, id))
+			}
+			return sb
+		},
+	}
+}
+
+func WithReportLimit(limit int) GetReportsOption {
+	return reportOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if limit > 0 {
+				sb = sb.Limit(uint64(limit))
+			}
+			return sb
+		},
+	}
+}
+
+func WithReportOffset(offset int) GetReportsOption {
+	return reportOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if offset > 0 {
+				sb = sb.Offset(uint64(offset))
+			}
+			return sb
+		},
+	}
+}
+
+func (s *SqlStorage) CreateReport(ctx context.Context, report *domain.Report) (*domain.Report, error) {
+	report.ID = uuid.NewString()
+	query, args := sq.Insert(reportTableName).
+		Columns("id", "target_type", "target_id", "reporter_id", "reason", "comment").
+		Values(report.ID, report.TargetType, report.TargetID, report.ReporterID, report.Reason, report.Comment).
+		Suffix(reportReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var created domain.Report
+	err := s.trf.Transaction(ctx).GetContext(ctx, &created, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrUniqueViolation {
+			return nil, ErrReportAlreadyExists
+		}
+		s.log(ctx).Error("failed to create report", zap.Error(err), zap.String("target_id", report.TargetID))
+		return nil, ErrReportInternal
+	}
+	return &created, nil
+}
+
+func (s *SqlStorage) GetReports(ctx context.Context, opts ...GetReportsOption) ([]*domain.Report, int, error) {
+	sb := sq.Select(reportSelectColumns...).
+		From(fmt.Sprintf("%s r", reportTableName)).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy("r.created_at DESC", "r.id DESC")
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		sb = opt.applySelect(sb)
+	}
+
+	query, args := sb.MustSql()
+	reports := make([]*domain.Report, 0, 10)
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &reports, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get reports", zap.Error(err))
+		return nil, 0, ErrReportInternal
+	}
+
+	count, err := s.CountReports(ctx, opts...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reports, count, nil
+}
+
+func (s *SqlStorage) CountReports(ctx context.Context, opts ...GetReportsOption) (int, error) {
+	sb := sq.Select("COUNT(*)").
+		From(fmt.Sprintf("%s r", reportTableName)).
+		PlaceholderFormat(sq.Dollar)
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		sb = opt.applyCount(sb)
+	}
+
+	query, args := sb.MustSql()
+	var count int
+	err := s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to count reports", zap.Error(err))
+		return 0, ErrReportInternal
+	}
+	return count, nil
+}
+
+// ResolveReports closes all open reports of the target with the decision and
+// returns them. It fails with ErrReportNotFound when none were open.
+func (s *SqlStorage) ResolveReports(ctx context.Context, resolution *domain.ReportResolution) ([]*domain.Report, error) {
+	query, args := sq.Update(reportTableName).
+		Set("status", resolution.Decision).
+		Set("resolved_by", resolution.ResolverID).
+		Set("resolution_note", resolution.Note).
+		Set("resolved_at", sq.Expr("NOW()")).
+		Where(sq.Eq{
+			"target_type": resolution.TargetType,
+			"target_id":   resolution.TargetID,
+			"status":      domain.ReportStatusOpen,
+		}).
+		Suffix(reportReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+
+	reports := make([]*domain.Report, 0)
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &reports, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to resolve reports", zap.Error(err), zap.String("target_id", resolution.TargetID))
+		return nil, ErrReportInternal
+	}
+	if len(reports) == 0 {
+		return nil, ErrReportNotFound
+	}
+	return reports, nil
+}
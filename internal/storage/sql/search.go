@@ -0,0 +1,84 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+)
+
+const (
+	searchConfig          = "russian"
+	searchHeadlineOptions = "StartSel=<b>, StopSel=</b>, MaxWords=35, MinWords=15, MaxFragments=2"
+)
+
+// WithCustomerSearch keeps customers whose name or about matches a web-style
+// search query (quoted phrases, "or", "-word") and orders them by relevance.
+func WithCustomerSearch(query string) GetCustomersOption {
+	match := sq.Expr(fmt.Sprintf("c.search_vector @@ websearch_to_tsquery('%s', ?)", searchConfig), query)
+	return customerOptionFunc{
+		selectFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if query == "" {
+				return sb
+			}
+			return sb.
+				Where(match).
+				OrderByClause(fmt.Sprintf("ts_rank_cd(c.search_vector, websearch_to_tsquery('%s', ?)) DESC", searchConfig), query)
+		},
+		countFn: func(sb sq.SelectBuilder) sq.SelectBuilder {
+			if query == "" {
+				return sb
+			}
+			return sb.Where(match)
+		},
+	}
+}
+
+func (s *SqlStorage) SearchCustomers(ctx context.Context, query string, opts ...GetCustomersOption) ([]*domain.CustomerSearchHit, int, error) {
+	opts = append([]GetCustomersOption{WithCustomerSearch(query)}, opts...)
+
+	sb := sq.Select(customerSelectColumns...).
+		Column(fmt.Sprintf("ts_rank_cd(c.search_vector, websearch_to_tsquery('%s', ?)) AS rank", searchConfig), query).
+		Column(fmt.Sprintf("ts_headline('%s', %s, websearch_to_tsquery('%s', ?), 'HighlightAll=true') AS name_highlight", searchConfig, htmlEscaped("c.name"), searchConfig), query).
+		Column(fmt.Sprintf("ts_headline('%s', %s, websearch_to_tsquery('%s', ?), '%s') AS about_snippet", searchConfig, htmlEscaped("c.about"), searchConfig, searchHeadlineOptions), query).
+		From(fmt.Sprintf("%s c", customerTableName)).
+		Where(sq.Eq{"c.deleted_at": nil, "c.hidden_at": nil}).
+		PlaceholderFormat(sq.Dollar)
+
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		sb = opt.applySelect(sb)
+	}
+
+	sqlQuery, args := sb.OrderBy("c.created_at DESC", "c.max_id DESC").MustSql()
+
+	hits := make([]*domain.CustomerSearchHit, 0)
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &hits, sqlQuery, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to search customers", zap.Error(err), zap.String("query", query))
+		return nil, 0, ErrCustomerInternal
+	}
+
+	count, err := s.CountCustomers(ctx, opts...)
+	if err != nil {
+		s.log(ctx).Error("failed to count searched customers", zap.Error(err), zap.String("query", query))
+		return nil, 0, ErrCustomerInternal
+	}
+
+	return hits, count, nil
+}
+
+// htmlEscaped escapes the user-written text of column, since ts_headline copies
+// it as is and the highlight tags are the only markup a hit may carry.
+func htmlEscaped(column string) string {
+	escaped := column
+	for _, r := range [][2]string{{"&", "&amp;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&quot;"}, {"'", "&#39;"}} {
+		escaped = fmt.Sprintf("replace(%s, '%s', '%s')", escaped, strings.ReplaceAll(r[0], "'", "''"), r[1])
+	}
+	return escaped
+}
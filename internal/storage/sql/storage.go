@@ -3,6 +3,8 @@ package sql
 import (
 	"DobrikaDev/customer-service/internal/storage/deps"
 	"DobrikaDev/customer-service/utils/config"
+	"DobrikaDev/customer-service/utils/logger"
+	"context"
 	"database/sql"
 	"fmt"
 	"time"
@@ -57,3 +59,8 @@ func MustCreateDB(cfg *config.Config) *sqlx.DB {
 
 	return db
 }
+
+// log returns the request-scoped logger of ctx.
+func (s *SqlStorage) log(ctx context.Context) *zap.Logger {
+	return logger.FromContext(ctx, s.logger)
+}
@@ -0,0 +1,29 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/storage/deps"
+	"DobrikaDev/customer-service/internal/storage/sqlxtrm"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// newMockStorage returns a storage on a mocked database that expects exactly
+// the queries a test sets up, compared as written.
+func newMockStorage(t *testing.T) (*SqlStorage, sqlmock.Sqlmock) {
+	t.Helper()
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	if err != nil {
+		t.Fatalf("sqlmock.New() error = %v", err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet database expectations: %v", err)
+		}
+		db.Close()
+	})
+	trf := sqlxtrm.NewSqlxTransactionFactory(sqlx.NewDb(db, "pgx"))
+	return NewStorage(trf, deps.NewTrmStub(), zap.NewNop()), mock
+}
@@ -0,0 +1,156 @@
+package sql
+
+import (
+	"DobrikaDev/customer-service/internal/domain"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+)
+
+const (
+	volunteerFeedbackTableName       = "volunteer_feedbacks"
+	volunteerFeedbackReturningSuffix = "RETURNING id, customer_id, user_id, task_id, rating, comment, screening, created_at, updated_at"
+)
+
+var volunteerFeedbackSelectColumns = []string{
+	"f.id",
+	"f.customer_id",
+	"f.user_id",
+	"f.task_id",
+	"f.rating",
+	"f.comment",
+	"f.screening",
+	"f.created_at",
+	"f.updated_at",
+}
+
+// WithRevealedFeedbacks keeps only the user reviews of customers that are no
+// longer blind: the customer has reviewed the volunteer for the same task, or
+// the review is older than revealAfter when that is positive.
+func WithRevealedFeedbacks(revealAfter time.Duration) GetFeedbacksOptions {
+	return revealedOption(volunteerFeedbackTableName, revealAfter)
+}
+
+// WithRevealedVolunteerFeedbacks is WithRevealedFeedbacks for the reviews
+// customers leave about volunteers.
+func WithRevealedVolunteerFeedbacks(revealAfter time.Duration) GetFeedbacksOptions {
+	return revealedOption("feedbacks", revealAfter)
+}
+
+func revealedOption(counterpartTable string, revealAfter time.Duration) GetFeedbacksOptions {
+	counterpart := sq.Expr(fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM %s cp WHERE cp.user_id = f.user_id AND cp.customer_id = f.customer_id AND cp.task_id = f.task_id)",
+		counterpartTable,
+	))
+	if revealAfter <= 0 {
+		return feedbackWhereOption(true, counterpart)
+	}
+	return feedbackWhereOption(true, sq.Or{counterpart, sq.LtOrEq{"f.created_at": time.Now().Add(-revealAfter)}})
+}
+
+func WithFeedbackID(id string) GetFeedbacksOptions {
+	return feedbackWhereOption(id != "", sq.Eq{"f.id": id})
+}
+
+func (s *SqlStorage) CreateVolunteerFeedback(ctx context.Context, feedback *domain.VolunteerFeedback) (*domain.VolunteerFeedback, error) {
+	feedback.ID = uuid.NewString()
+	query, args := sq.Insert(volunteerFeedbackTableName).
+		Columns("id", "customer_id", "user_id", "task_id", "rating", "comment", "screening").
+		Values(feedback.ID, feedback.CustomerID, feedback.UserID, feedback.TaskID, feedback.Rating, feedback.Comment, feedback.Screening).
+		Suffix(volunteerFeedbackReturningSuffix).
+		PlaceholderFormat(sq.Dollar).
+		MustSql()
+	var created domain.VolunteerFeedback
+	err := s.trf.Transaction(ctx).GetContext(ctx, &created, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgErrUniqueViolation:
+				return nil, ErrFeedbackAlreadyExists
+			case pgErrForeignKeyViolation:
+				return nil, ErrFeedbackInvalid
+			}
+		}
+		s.log(ctx).Error("failed to create volunteer feedback", zap.Error(err), zap.String("customer_id", feedback.CustomerID), zap.String("task_id", feedback.TaskID))
+		return nil, ErrFeedbackInternal
+	}
+	return &created, nil
+}
+
+func (s *SqlStorage) GetVolunteerFeedbacks(ctx context.Context, opts ...GetFeedbacksOptions) ([]*domain.VolunteerFeedback, int, error) {
+	sb := sq.Select(volunteerFeedbackSelectColumns...).
+		From(fmt.Sprintf("%s f", volunteerFeedbackTableName)).
+		PlaceholderFormat(sq.Dollar).
+		OrderBy("f.created_at DESC", "f.id DESC")
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		sb = opt.applySelect(sb)
+	}
+
+	query, args := sb.MustSql()
+	feedbacks := make([]*domain.VolunteerFeedback, 0, 10)
+	err := s.trf.Transaction(ctx).SelectContext(ctx, &feedbacks, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get volunteer feedbacks", zap.Error(err))
+		return nil, 0, ErrFeedbackInternal
+	}
+
+	cb := sq.Select("COUNT(*)").
+		From(fmt.Sprintf("%s f", volunteerFeedbackTableName)).
+		PlaceholderFormat(sq.Dollar)
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		cb = opt.applyCount(cb)
+	}
+
+	query, args = cb.MustSql()
+	var count int
+	err = s.trf.Transaction(ctx).GetContext(ctx, &count, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to count volunteer feedbacks", zap.Error(err))
+		return nil, 0, ErrFeedbackInternal
+	}
+
+	return feedbacks, count, nil
+}
+
+// GetVolunteerRating sums up the ratings of a volunteer over the reviews that
+// match opts.
+func (s *SqlStorage) GetVolunteerRating(ctx context.Context, userID string, opts ...GetFeedbacksOptions) (*domain.VolunteerRating, error) {
+	sb := sq.Select(ratingCountColumns...).
+		From(fmt.Sprintf("%s f", volunteerFeedbackTableName)).
+		Where(sq.Eq{"f.user_id": userID}).
+		PlaceholderFormat(sq.Dollar)
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		sb = opt.applyCount(sb)
+	}
+
+	query, args := sb.MustSql()
+	var row ratingCountsRow
+	err := s.trf.Transaction(ctx).GetContext(ctx, &row, query, args...)
+	if err != nil {
+		s.log(ctx).Error("failed to get volunteer rating", zap.Error(err), zap.String("user_id", userID))
+		return nil, ErrFeedbackInternal
+	}
+
+	return &domain.VolunteerRating{
+		UserID:       userID,
+		Count:        row.Count,
+		Sum:          row.Sum,
+		Distribution: row.distribution(),
+	}, nil
+}
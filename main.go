@@ -2,33 +2,94 @@ package main
 
 import (
 	"DobrikaDev/customer-service/di"
-	customerpb "DobrikaDev/customer-service/internal/generated/proto/customer"
 	"DobrikaDev/customer-service/utils/config"
 	"DobrikaDev/customer-service/utils/logger"
 	"context"
+	"errors"
+	"net/http"
 	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
 
 	"go.uber.org/zap"
 )
 
 func main() {
-	ctx := context.Background()
-	cfg := config.MustLoadConfigFromFile("deployments/config.yaml")
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	logger, _ := logger.NewLogger()
 	defer logger.Sync()
+	cfg, err := config.LoadConfigFromFile("deployments/config.yaml")
+	if err == nil {
+		err = cfg.Validate()
+	}
+	if err != nil {
+		logger.Fatal("Invalid config", zap.Error(err))
+	}
+
+	// The container outlives the signal, its servers drain in Close.
+	container := di.NewContainer(context.Background(), cfg, logger)
+	listener := container.GetNetListener()
+	// built first and so closed last, the probes answer while the rest drains
+	var probeServer *http.Server
+	if cfg.Health.Port != "" {
+		probeServer = container.GetProbeServer()
+	}
+	grpcServer := container.GetGRPCServer()
+	var gatewayServer *http.Server
+	if cfg.Gateway.Port != "" {
+		gatewayServer = container.GetGatewayServer()
+	}
 
-	container := di.NewContainer(ctx, cfg, logger)
+	container.Go(container.GetCustomerService().RunPurger)
+	container.Go(container.GetHealthChecker().Run)
 
-	customerpb.RegisterCustomerServiceServer(
-		container.GetGRPCServer(),
-		container.GetRpcServer(),
-	)
+	var failed atomic.Bool
+	if probeServer != nil {
+		go func() {
+			logger.Info("Starting probes with port", zap.String("port", cfg.Health.Port))
+			err := probeServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("Error while serving probes:", zap.Error(err))
+				failed.Store(true)
+				stop()
+			}
+		}()
+	}
 
-	logger.Info("Starting application with port", zap.String("port", cfg.Port))
+	if gatewayServer != nil {
+		go func() {
+			logger.Info("Starting gateway with port", zap.String("port", cfg.Gateway.Port))
+			err := gatewayServer.ListenAndServe()
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("Error while serving gateway:", zap.Error(err))
+				failed.Store(true)
+				stop()
+			}
+		}()
+	}
 
-	err := container.GetGRPCServer().Serve(*container.GetNetListener())
-	if err != nil {
-		logger.Error("Error while serving grpcServer:", zap.Error(err))
+	go func() {
+		logger.Info("Starting application with port", zap.String("port", cfg.Port))
+		err := grpcServer.Serve(*listener)
+		if err != nil {
+			logger.Error("Error while serving grpcServer:", zap.Error(err))
+			failed.Store(true)
+			stop()
+		}
+	}()
+
+	<-ctx.Done()
+	logger.Info("Shutting down", zap.Duration("timeout", cfg.ShutdownTimeout))
+	container.GetHealthChecker().Shutdown()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := container.Close(shutdownCtx); err != nil {
+		failed.Store(true)
+	}
+	if failed.Load() {
 		os.Exit(1)
 	}
 }
@@ -0,0 +1,26 @@
+// Package migrations embeds the database migrations, so the migrator and the
+// readiness check agree on the latest version.
+package migrations
+
+import (
+	"database/sql"
+	"embed"
+	"io/fs"
+
+	"github.com/pressly/goose/v3"
+)
+
+//go:embed postgres/*.sql
+var postgres embed.FS
+
+// TableName is where goose records the applied versions.
+const TableName = "migrations"
+
+// NewProvider returns a goose provider for the embedded postgres migrations.
+func NewProvider(db *sql.DB) (*goose.Provider, error) {
+	fsys, err := fs.Sub(postgres, "postgres")
+	if err != nil {
+		return nil, err
+	}
+	return goose.NewProvider(goose.DialectPostgres, db, fsys, goose.WithTableName(TableName))
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE customers ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('russian', coalesce(name, '')), 'A') ||
+    setweight(to_tsvector('russian', coalesce(about, '')), 'B')
+) STORED;
+
+CREATE INDEX idx_customers_search_vector ON customers USING GIN (search_vector);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_customers_search_vector;
+ALTER TABLE customers DROP COLUMN search_vector;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE feedback_revisions (
+    id VARCHAR(255) PRIMARY KEY,
+    feedback_id VARCHAR(255) NOT NULL,
+    customer_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    action VARCHAR(32) NOT NULL,
+    rating INT NOT NULL,
+    comment TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_feedback_revisions_feedback_id ON feedback_revisions (feedback_id);
+CREATE INDEX idx_feedback_revisions_customer_id ON feedback_revisions (customer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE feedback_revisions;
+-- +goose StatementEnd
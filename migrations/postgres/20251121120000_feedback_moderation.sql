@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feedbacks ADD COLUMN status VARCHAR(32) NOT NULL DEFAULT 'published';
+ALTER TABLE feedbacks ALTER COLUMN status SET DEFAULT 'pending';
+
+CREATE INDEX idx_feedbacks_status_created_at ON feedbacks (status, created_at DESC);
+
+CREATE TABLE feedback_moderations (
+    id VARCHAR(255) PRIMARY KEY,
+    feedback_id VARCHAR(255) NOT NULL,
+    moderator_id VARCHAR(255) NOT NULL,
+    decision VARCHAR(32) NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+ALTER TABLE feedback_moderations ADD CONSTRAINT fk_feedback_moderations_feedbacks FOREIGN KEY (feedback_id) REFERENCES feedbacks (id) ON DELETE CASCADE;
+CREATE INDEX idx_feedback_moderations_feedback_id ON feedback_moderations (feedback_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE feedback_moderations;
+DROP INDEX idx_feedbacks_status_created_at;
+ALTER TABLE feedbacks DROP COLUMN status;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE feedbacks ADD COLUMN screening JSONB;
+ALTER TABLE customers ADD COLUMN screening JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE customers DROP COLUMN screening;
+ALTER TABLE feedbacks DROP COLUMN screening;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE feedback_replies (
+    id VARCHAR(255) PRIMARY KEY,
+    feedback_id VARCHAR(255) NOT NULL UNIQUE,
+    customer_id VARCHAR(255) NOT NULL,
+    text TEXT NOT NULL,
+    screening JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+ALTER TABLE feedback_replies ADD CONSTRAINT fk_feedback_replies_feedbacks FOREIGN KEY (feedback_id) REFERENCES feedbacks (id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE feedback_replies;
+-- +goose StatementEnd
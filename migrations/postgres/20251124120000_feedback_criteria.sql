@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE feedback_criterion_scores (
+    feedback_id VARCHAR(255) NOT NULL,
+    criterion VARCHAR(64) NOT NULL,
+    score INT NOT NULL CHECK (score BETWEEN 1 AND 5),
+    PRIMARY KEY (feedback_id, criterion)
+);
+
+ALTER TABLE feedback_criterion_scores ADD CONSTRAINT fk_feedback_criterion_scores_feedbacks FOREIGN KEY (feedback_id) REFERENCES feedbacks (id) ON DELETE CASCADE;
+
+CREATE TABLE customer_criterion_ratings (
+    customer_id VARCHAR(255) NOT NULL,
+    criterion VARCHAR(64) NOT NULL,
+    ratings_count INT NOT NULL DEFAULT 0,
+    ratings_sum BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (customer_id, criterion)
+);
+
+ALTER TABLE customer_criterion_ratings ADD CONSTRAINT fk_customer_criterion_ratings_customers FOREIGN KEY (customer_id) REFERENCES customers (max_id) ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE customer_criterion_ratings;
+DROP TABLE feedback_criterion_scores;
+-- +goose StatementEnd
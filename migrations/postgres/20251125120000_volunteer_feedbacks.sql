@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE volunteer_feedbacks (
+    id VARCHAR(255) PRIMARY KEY,
+    customer_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    task_id VARCHAR(255) NOT NULL,
+    rating INT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    comment TEXT NOT NULL,
+    screening JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    UNIQUE (customer_id, user_id, task_id)
+);
+
+ALTER TABLE volunteer_feedbacks ADD CONSTRAINT fk_volunteer_feedbacks_customers FOREIGN KEY (customer_id) REFERENCES customers (max_id) ON DELETE CASCADE;
+ALTER TABLE volunteer_feedbacks ADD CONSTRAINT fk_volunteer_feedbacks_users FOREIGN KEY (user_id) REFERENCES users (max_id);
+CREATE INDEX idx_volunteer_feedbacks_user_id_created_at ON volunteer_feedbacks (user_id, created_at DESC);
+
+-- lets the blind review check find the counterpart review of a task
+CREATE INDEX idx_feedbacks_user_customer_task ON feedbacks (user_id, customer_id, task_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_feedbacks_user_customer_task;
+DROP TABLE volunteer_feedbacks;
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TEMPORARY TABLE duplicate_feedbacks ON COMMIT DROP AS
+SELECT id
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY user_id, task_id, customer_id ORDER BY created_at DESC, id DESC) AS rn
+    FROM feedbacks
+) ranked
+WHERE rn > 1;
+
+-- keep the text of the dropped duplicates like any other deleted feedback
+INSERT INTO feedback_revisions (id, feedback_id, customer_id, user_id, action, rating, comment)
+SELECT gen_random_uuid()::text, f.id, f.customer_id, f.user_id, 'delete', f.rating, f.comment
+FROM feedbacks f
+JOIN duplicate_feedbacks d ON d.id = f.id;
+
+DELETE FROM feedbacks f USING duplicate_feedbacks d WHERE f.id = d.id;
+
+-- the summaries still count the duplicates, rebuild them from what is left
+DELETE FROM customer_ratings;
+INSERT INTO customer_ratings (customer_id, ratings_count, ratings_sum, stars_1, stars_2, stars_3, stars_4, stars_5)
+SELECT
+    customer_id,
+    COUNT(*),
+    SUM(rating),
+    COUNT(*) FILTER (WHERE rating = 1),
+    COUNT(*) FILTER (WHERE rating = 2),
+    COUNT(*) FILTER (WHERE rating = 3),
+    COUNT(*) FILTER (WHERE rating = 4),
+    COUNT(*) FILTER (WHERE rating = 5)
+FROM feedbacks
+WHERE status = 'published'
+GROUP BY customer_id;
+
+DELETE FROM customer_criterion_ratings;
+INSERT INTO customer_criterion_ratings (customer_id, criterion, ratings_count, ratings_sum)
+SELECT f.customer_id, s.criterion, COUNT(*), SUM(s.score)
+FROM feedback_criterion_scores s
+JOIN feedbacks f ON f.id = s.feedback_id
+WHERE f.status = 'published'
+GROUP BY f.customer_id, s.criterion;
+
+DROP INDEX idx_feedbacks_user_customer_task;
+ALTER TABLE feedbacks ADD CONSTRAINT uq_feedbacks_user_task_customer UNIQUE (user_id, task_id, customer_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE feedbacks DROP CONSTRAINT uq_feedbacks_user_task_customer;
+CREATE INDEX idx_feedbacks_user_customer_task ON feedbacks (user_id, customer_id, task_id);
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE feedback_abuse_assessments (
+    feedback_id VARCHAR(255) PRIMARY KEY,
+    score DOUBLE PRECISION NOT NULL,
+    velocity DOUBLE PRECISION NOT NULL,
+    burst DOUBLE PRECISION NOT NULL,
+    account_age DOUBLE PRECISION NOT NULL,
+    deviation DOUBLE PRECISION NOT NULL,
+    held BOOLEAN NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+ALTER TABLE feedback_abuse_assessments ADD CONSTRAINT fk_feedback_abuse_assessments_feedbacks FOREIGN KEY (feedback_id) REFERENCES feedbacks (id) ON DELETE CASCADE;
+CREATE INDEX idx_feedbacks_customer_id_created_at ON feedbacks (customer_id, created_at DESC);
+CREATE INDEX idx_feedbacks_user_id_created_at ON feedbacks (user_id, created_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_feedbacks_user_id_created_at;
+DROP INDEX idx_feedbacks_customer_id_created_at;
+DROP TABLE feedback_abuse_assessments;
+-- +goose StatementEnd
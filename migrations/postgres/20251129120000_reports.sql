@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE reports (
+    id VARCHAR(255) PRIMARY KEY,
+    target_type VARCHAR(32) NOT NULL,
+    target_id VARCHAR(255) NOT NULL,
+    reporter_id VARCHAR(255) NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    status VARCHAR(32) NOT NULL DEFAULT 'open',
+    resolved_by VARCHAR(255) NOT NULL DEFAULT '',
+    resolution_note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    resolved_at TIMESTAMP WITH TIME ZONE
+);
+
+ALTER TABLE reports ADD CONSTRAINT uq_reports_target_reporter UNIQUE (target_type, target_id, reporter_id);
+CREATE INDEX idx_reports_status_created_at ON reports (status, created_at DESC);
+
+ALTER TABLE customers ADD COLUMN hidden_at TIMESTAMP WITH TIME ZONE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE customers DROP COLUMN hidden_at;
+DROP TABLE reports;
+-- +goose StatementEnd
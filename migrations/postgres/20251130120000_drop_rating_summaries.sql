@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ratings are summed up from the published feedbacks on read, so that reviews
+-- still under the blind period never show through
+DROP TABLE customer_criterion_ratings;
+DROP TABLE customer_ratings;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE TABLE customer_ratings (
+    customer_id VARCHAR(255) PRIMARY KEY,
+    ratings_count INT NOT NULL DEFAULT 0,
+    ratings_sum BIGINT NOT NULL DEFAULT 0,
+    stars_1 INT NOT NULL DEFAULT 0,
+    stars_2 INT NOT NULL DEFAULT 0,
+    stars_3 INT NOT NULL DEFAULT 0,
+    stars_4 INT NOT NULL DEFAULT 0,
+    stars_5 INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now()
+);
+
+ALTER TABLE customer_ratings ADD CONSTRAINT fk_customer_ratings_customers FOREIGN KEY (customer_id) REFERENCES customers (max_id) ON DELETE CASCADE;
+
+INSERT INTO customer_ratings (customer_id, ratings_count, ratings_sum, stars_1, stars_2, stars_3, stars_4, stars_5)
+SELECT
+    customer_id,
+    COUNT(*),
+    SUM(rating),
+    COUNT(*) FILTER (WHERE rating = 1),
+    COUNT(*) FILTER (WHERE rating = 2),
+    COUNT(*) FILTER (WHERE rating = 3),
+    COUNT(*) FILTER (WHERE rating = 4),
+    COUNT(*) FILTER (WHERE rating = 5)
+FROM feedbacks
+WHERE status = 'published'
+GROUP BY customer_id;
+
+CREATE TABLE customer_criterion_ratings (
+    customer_id VARCHAR(255) NOT NULL,
+    criterion VARCHAR(64) NOT NULL,
+    ratings_count INT NOT NULL DEFAULT 0,
+    ratings_sum BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT now(),
+    PRIMARY KEY (customer_id, criterion)
+);
+
+ALTER TABLE customer_criterion_ratings ADD CONSTRAINT fk_customer_criterion_ratings_customers FOREIGN KEY (customer_id) REFERENCES customers (max_id) ON DELETE CASCADE;
+
+INSERT INTO customer_criterion_ratings (customer_id, criterion, ratings_count, ratings_sum)
+SELECT f.customer_id, s.criterion, COUNT(*), SUM(s.score)
+FROM feedback_criterion_scores s
+JOIN feedbacks f ON f.id = s.feedback_id
+WHERE f.status = 'published'
+GROUP BY f.customer_id, s.criterion;
+-- +goose StatementEnd
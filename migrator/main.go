@@ -2,12 +2,12 @@ package main
 
 import (
 	"DobrikaDev/customer-service/di"
+	"DobrikaDev/customer-service/migrations"
 	"DobrikaDev/customer-service/utils/config"
 	"DobrikaDev/customer-service/utils/logger"
 	"context"
 	"os"
 
-	"github.com/pressly/goose/v3"
 	"go.uber.org/zap"
 )
 
@@ -18,14 +18,13 @@ func main() {
 	defer logger.Sync()
 	container := di.NewContainer(ctx, cfg, logger)
 
-	goose.SetTableName("migrations")
-
-	if err := goose.SetDialect("postgres"); err != nil {
-		logger.Error("Error setting dialect:", zap.Error(err))
+	provider, err := migrations.NewProvider(container.GetDB().DB)
+	if err != nil {
+		logger.Error("Error creating migration provider:", zap.Error(err))
 		os.Exit(1)
 	}
 
-	if err := goose.Up(container.GetDB().DB, "migrations/postgres"); err != nil {
+	if _, err := provider.Up(ctx); err != nil {
 		logger.Error("Error running migrations:", zap.Error(err))
 		os.Exit(1)
 	}
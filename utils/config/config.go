@@ -1,14 +1,51 @@
 package config
 
 import (
+	"errors"
+	"time"
+
 	"github.com/ilyakaznacheev/cleanenv"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
 	Port string `mapstructure:"port" env:"PORT"`
+	// ErrorMode is how failed calls are reported: inband puts the error only
+	// into the response, status only into the gRPC status, and dual, the
+	// default, into the response with the status in the call trailer.
+	ErrorMode string `mapstructure:"error_mode" env:"ERROR_MODE"`
+	// ShutdownTimeout is how long in-flight calls may drain after SIGTERM
+	// before the servers are stopped hard. Zero stops them right away.
+	ShutdownTimeout time.Duration `mapstructure:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT"`
 
 	SQL DB `mapstructure:"sql" env-prefix:"POSTGRES_"`
+
+	Gateway Gateway `mapstructure:"gateway" env-prefix:"GATEWAY_"`
+	Health  Health  `mapstructure:"health" env-prefix:"HEALTH_"`
+
+	Reputation Reputation `mapstructure:"reputation" env-prefix:"REPUTATION_"`
+	Purge      Purge      `mapstructure:"purge" env-prefix:"PURGE_"`
+	Pagination Pagination `mapstructure:"pagination" env-prefix:"PAGINATION_"`
+	Feedback   Feedback   `mapstructure:"feedback" env-prefix:"FEEDBACK_"`
+	Screening  Screening  `mapstructure:"screening" env-prefix:"SCREENING_"`
+	Task       Task       `mapstructure:"task" env-prefix:"TASK_"`
+	Abuse      Abuse      `mapstructure:"abuse" env-prefix:"ABUSE_"`
+	RateLimit  RateLimit  `mapstructure:"rate_limit" env-prefix:"RATE_LIMIT_"`
+	Reports    Reports    `mapstructure:"reports" env-prefix:"REPORTS_"`
+}
+
+// Gateway serves the REST/JSON API on its own Port. An empty Port turns the
+// gateway off.
+type Gateway struct {
+	Port string `mapstructure:"port" env:"PORT"`
+}
+
+// Health checks the dependencies every Interval, each check given Timeout.
+// The HTTP probes are served on Port, an empty Port turns them off.
+type Health struct {
+	Port     string        `mapstructure:"port" env:"PORT"`
+	Interval time.Duration `mapstructure:"interval" env:"INTERVAL"`
+	Timeout  time.Duration `mapstructure:"timeout" env:"TIMEOUT"`
 }
 
 type DB struct {
@@ -19,6 +56,130 @@ type DB struct {
 	Name     string `mapstructure:"name" env:"NAME"`
 }
 
+type Reputation struct {
+	PriorMean   float64       `mapstructure:"prior_mean" env:"PRIOR_MEAN"`
+	PriorWeight float64       `mapstructure:"prior_weight" env:"PRIOR_WEIGHT"`
+	HalfLife    time.Duration `mapstructure:"half_life" env:"HALF_LIFE"`
+}
+
+// Purge controls how long soft-deleted customers are kept before the
+// background job removes them. A zero Retention disables the job.
+type Purge struct {
+	Retention time.Duration `mapstructure:"retention" env:"RETENTION"`
+	Interval  time.Duration `mapstructure:"interval" env:"INTERVAL"`
+	BatchSize int           `mapstructure:"batch_size" env:"BATCH_SIZE"`
+}
+
+// Pagination signs page tokens with TokenSecret. The secret is not kept in the
+// config file but taken from PAGINATION_TOKEN_SECRET.
+type Pagination struct {
+	TokenSecret string `mapstructure:"token_secret" env:"TOKEN_SECRET"`
+}
+
+// Feedback holds the rules for feedback after it was left. A zero EditWindow
+// lets authors edit and delete their feedback at any time. With Premoderation
+// new and edited feedback stays pending until a moderator publishes it.
+type Feedback struct {
+	EditWindow    time.Duration `mapstructure:"edit_window" env:"EDIT_WINDOW"`
+	Premoderation bool          `mapstructure:"premoderation" env:"PREMODERATION"`
+	// Criteria lists the aspects a feedback may score separately from the
+	// overall rating, e.g. organisation or safety.
+	Criteria []string `mapstructure:"criteria" env:"CRITERIA" env-separator:","`
+	// With BlindReview a review of a task, in either direction, stays hidden
+	// until the other side has reviewed the same task too or RevealAfter has
+	// passed since it was written. A zero RevealAfter waits for both sides.
+	BlindReview bool          `mapstructure:"blind_review" env:"BLIND_REVIEW"`
+	RevealAfter time.Duration `mapstructure:"reveal_after" env:"REVEAL_AFTER"`
+}
+
+// Screening configures the built-in content filter. Words are matched as
+// stems, optionally behind one of Prefixes, so that a single entry covers its
+// Russian word forms. Actions are allow, mask, moderate or reject.
+type Screening struct {
+	Enabled      bool     `mapstructure:"enabled" env:"ENABLED"`
+	ObsceneWords []string `mapstructure:"obscene_words" env:"OBSCENE_WORDS" env-separator:","`
+	SpamWords    []string `mapstructure:"spam_words" env:"SPAM_WORDS" env-separator:","`
+	Prefixes     []string `mapstructure:"prefixes" env:"PREFIXES" env-separator:","`
+
+	ObsceneAction string `mapstructure:"obscene_action" env:"OBSCENE_ACTION"`
+	ContactAction string `mapstructure:"contact_action" env:"CONTACT_ACTION"`
+	LinkAction    string `mapstructure:"link_action" env:"LINK_ACTION"`
+	SpamAction    string `mapstructure:"spam_action" env:"SPAM_ACTION"`
+}
+
+// Task points at the task service used to check that a reviewer took part in
+// the task. An Address is required unless AllowAll, meant for local runs only,
+// accepts every participation without asking. FailOpen lets feedback through
+// while the task service is unreachable; otherwise it is refused until the
+// service is back.
+type Task struct {
+	Address  string        `mapstructure:"address" env:"ADDRESS"`
+	AllowAll bool          `mapstructure:"allow_all" env:"ALLOW_ALL"`
+	Timeout  time.Duration `mapstructure:"timeout" env:"TIMEOUT"`
+	CacheTTL time.Duration `mapstructure:"cache_ttl" env:"CACHE_TTL"`
+	FailOpen bool          `mapstructure:"fail_open" env:"FAIL_OPEN"`
+}
+
+// Abuse tunes the scoring of new feedback. Velocity and burst count the
+// feedback written within Window by the same user and against the same
+// customer; they saturate at VelocityLimit and BurstLimit. Accounts younger
+// than MinAccountAge and ratings far from an average built on at least
+// MinRatings reviews add to the score. Feedback scoring HoldThreshold or more
+// is held for moderation.
+type Abuse struct {
+	Enabled       bool          `mapstructure:"enabled" env:"ENABLED"`
+	Window        time.Duration `mapstructure:"window" env:"WINDOW"`
+	VelocityLimit int           `mapstructure:"velocity_limit" env:"VELOCITY_LIMIT"`
+	BurstLimit    int           `mapstructure:"burst_limit" env:"BURST_LIMIT"`
+	MinAccountAge time.Duration `mapstructure:"min_account_age" env:"MIN_ACCOUNT_AGE"`
+	MinRatings    int           `mapstructure:"min_ratings" env:"MIN_RATINGS"`
+	HoldThreshold float64       `mapstructure:"hold_threshold" env:"HOLD_THRESHOLD"`
+}
+
+// RateLimit throttles feedback and customer creation with token buckets.
+// Caller limits every creation call per calling client, Feedback limits it per
+// user and Customer limits account creation per calling client. Backend is
+// memory, which counts per replica, or postgres, which shares the buckets
+// between replicas.
+type RateLimit struct {
+	Enabled  bool            `mapstructure:"enabled" env:"ENABLED"`
+	Backend  string          `mapstructure:"backend" env:"BACKEND"`
+	Caller   RateLimitBucket `mapstructure:"caller" env-prefix:"CALLER_"`
+	Feedback RateLimitBucket `mapstructure:"feedback" env-prefix:"FEEDBACK_"`
+	Customer RateLimitBucket `mapstructure:"customer" env-prefix:"CUSTOMER_"`
+	// TrustedProxies lists the addresses or CIDRs of the proxies allowed to
+	// name the caller with X-Caller-Id and X-Forwarded-For. The gateway on
+	// loopback is always trusted.
+	TrustedProxies []string `mapstructure:"trusted_proxies" env:"TRUSTED_PROXIES" env-separator:","`
+}
+
+// RateLimitBucket allows Limit requests per Period, all of them at once at
+// most. A zero Limit turns the bucket off.
+type RateLimitBucket struct {
+	Limit  int           `mapstructure:"limit" env:"LIMIT"`
+	Period time.Duration `mapstructure:"period" env:"PERIOD"`
+}
+
+// Reports hides a customer or a published feedback once HideThreshold
+// distinct users have open reports against it, until an admin resolves them.
+// A zero HideThreshold leaves hiding to admins.
+type Reports struct {
+	HideThreshold int `mapstructure:"hide_threshold" env:"HIDE_THRESHOLD"`
+}
+
+// Validate reports settings the service cannot run with.
+func (c *Config) Validate() error {
+	switch {
+	case c.Pagination.TokenSecret == "":
+		return errors.New("pagination.token_secret is empty, set PAGINATION_TOKEN_SECRET")
+	case c.Task.Address == "" && !c.Task.AllowAll:
+		return errors.New("task.address is required, set task.allow_all to run without a task service")
+	case c.Task.Address != "" && c.Task.AllowAll:
+		return errors.New("task.allow_all cannot be combined with task.address")
+	}
+	return nil
+}
+
 func LoadConfigFromFile(path string) (*Config, error) {
 	config := new(Config)
 	viper.SetConfigFile(path)
@@ -26,6 +187,11 @@ func LoadConfigFromFile(path string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	// secrets come from the environment, see Pagination
+	err = viper.BindEnv("pagination.token_secret", "PAGINATION_TOKEN_SECRET")
+	if err != nil {
+		return nil, err
+	}
 
 	err = viper.Unmarshal(config)
 	if err != nil {